@@ -48,6 +48,10 @@ func (vpcs *VPCSession) AttachVolume(volumeAttachmentRequest provider.VolumeAtta
 	if err != nil {
 		return nil, err
 	}
+	instance, err := vpcs.validateAttachTarget(volumeAttachmentRequest)
+	if err != nil {
+		return nil, err
+	}
 	var volumeAttachResult *models.VolumeAttachment
 	var varp *provider.VolumeAttachmentResponse
 	// If it is Non IKS environment then remove the IKSVolumeAttachment field from request struct which contains clusterID.
@@ -56,8 +60,12 @@ func (vpcs *VPCSession) AttachVolume(volumeAttachmentRequest provider.VolumeAtta
 		volumeAttachmentRequest.IKSVolumeAttachment = nil
 	}
 	volumeAttachment := models.NewVolumeAttachment(volumeAttachmentRequest)
+	err = vpcs.checkAttachmentLimit(volumeAttachment, instance)
+	if err != nil {
+		return nil, err
+	}
 
-	err = vpcs.APIRetry.FlexyRetry(vpcs.Logger, func() (error, bool) {
+	err = vpcs.APIRetry.FlexyRetry(vpcs.GetContext(), vpcs.Logger, func() (error, bool) {
 		// First , check if volume is already attached or attaching to given instance
 		vpcs.Logger.Info("Checking if volume is already attached by other thread")
 		currentVolAttachment, err := vpcs.GetVolumeAttachment(volumeAttachmentRequest)
@@ -68,7 +76,7 @@ func (vpcs *VPCSession) AttachVolume(volumeAttachmentRequest provider.VolumeAtta
 		}
 		//Try attaching volume if it's not already attached or there is error in getting current volume attachment
 		vpcs.Logger.Info("Attaching volume from VPC provider...", zap.Bool("IKSEnabled?", vpcs.Config.VPCConfig.IsIKS))
-		volumeAttachResult, err = vpcs.APIClientVolAttachMgr.AttachVolume(&volumeAttachment, vpcs.Logger)
+		volumeAttachResult, err = vpcs.APIClientVolAttachMgr.WithContext(vpcs.GetContext()).AttachVolume(&volumeAttachment, vpcs.Logger)
 		// Keep retry, until we get the proper volumeAttachResult object
 		if err != nil {
 			return err, skipRetryForObviousErrors(err, vpcs.Config.VPCConfig.IsIKS)
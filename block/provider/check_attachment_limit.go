@@ -0,0 +1,84 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package provider ...
+package provider
+
+import (
+	userError "github.com/IBM/ibmcloud-volume-vpc/common/messages"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
+	"go.uber.org/zap"
+)
+
+// bootVolumeAttachmentType is the type of the attachment of the boot volume, which does not count against the limit
+const bootVolumeAttachmentType = "boot"
+
+// checkAttachmentLimit counts the data volumes attached to the instance and fails if no more volumes can be attached,
+// so that the caller can pick another instance instead of waiting for the attach to fail. If the attachments can not be
+// listed the limit is left to the backend
+func (vpcs *VPCSession) checkAttachmentLimit(volumeAttachment models.VolumeAttachment, instance *models.Instance) error {
+	var profileName string
+	if instance != nil && instance.Profile != nil {
+		profileName = instance.Profile.Name
+	}
+	maxAttachments := vpcs.getMaxVolumeAttachments(profileName)
+	if maxAttachments <= 0 {
+		return nil
+	}
+
+	var volumeAttachmentList *models.VolumeAttachmentList
+	var err error
+	err = vpcs.APIRetry.FlexyRetry(vpcs.GetContext(), vpcs.Logger, func() (error, bool) {
+		volumeAttachmentList, err = vpcs.APIClientVolAttachMgr.WithContext(vpcs.GetContext()).ListVolumeAttachments(&volumeAttachment, vpcs.Logger)
+		if err != nil {
+			return err, skipRetryForObviousErrors(err, vpcs.Config.VPCConfig.IsIKS)
+		}
+		return err, true // stop retry as no error
+	})
+	if err != nil {
+		vpcs.Logger.Warn("Unable to list the volume attachments, skipping the attachment limit check", zap.Reflect("InstanceID", volumeAttachment.InstanceID), zap.Error(err))
+		return nil
+	}
+	if volumeAttachmentList == nil {
+		return nil
+	}
+
+	attachments := 0
+	for _, volumeAttachmentItem := range volumeAttachmentList.VolumeAttachments {
+		// An attachment of the requested volume is handled by the attach itself
+		if volumeAttachmentItem.Volume != nil && volumeAttachmentItem.Volume.ID == volumeAttachment.Volume.ID {
+			return nil
+		}
+		if volumeAttachmentItem.Type == bootVolumeAttachmentType {
+			continue
+		}
+		attachments++
+	}
+	vpcs.Logger.Info("Data volumes attached to the instance", zap.Reflect("InstanceID", volumeAttachment.InstanceID), zap.Reflect("InstanceProfile", profileName), zap.Int("Attachments", attachments), zap.Int("MaxAttachments", maxAttachments))
+
+	if attachments >= maxAttachments {
+		return userError.GetUserError("InstanceAttachmentLimitReached", nil, *volumeAttachment.InstanceID, attachments, maxAttachments)
+	}
+	return nil
+}
+
+// getMaxVolumeAttachments returns the configured maximum number of data volume attachments for the instance profile,
+// 0 if there is no limit configured
+func (vpcs *VPCSession) getMaxVolumeAttachments(profileName string) int {
+	if maxAttachments, ok := vpcs.Config.MaxVolumeAttachmentsPerProfile[profileName]; ok && len(profileName) > 0 {
+		return maxAttachments
+	}
+	return vpcs.Config.MaxVolumeAttachments
+}
@@ -0,0 +1,153 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package provider ...
+package provider
+
+import (
+	"testing"
+
+	"github.com/IBM/ibmcloud-volume-interface/lib/provider"
+	instanceFakes "github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/instances/fakes"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckAttachmentLimit(t *testing.T) {
+	//var err error
+	logger, teardown := GetTestLogger(t)
+	defer teardown()
+
+	instanceID := "instance-id1"
+	bootAttachment := models.VolumeAttachment{ID: "boot", Type: "boot", Volume: &models.Volume{ID: "boot-volume"}}
+	dataAttachment := func(volumeID string) models.VolumeAttachment {
+		return models.VolumeAttachment{ID: "attachment-" + volumeID, Type: "data", Volume: &models.Volume{ID: volumeID}}
+	}
+
+	testCases := []struct {
+		testCaseName             string
+		maxAttachments           int
+		maxAttachmentsPerProfile map[string]int
+		instance                 *models.Instance
+		attachments              *models.VolumeAttachmentList
+		attachmentsErr           error
+
+		expectedErr       string
+		expectedListCalls int
+	}{
+		{
+			testCaseName:   "No limit configured",
+			instance:       &models.Instance{Profile: &models.Profile{Name: "bx2-2x8"}},
+			attachments:    &models.VolumeAttachmentList{VolumeAttachments: []models.VolumeAttachment{bootAttachment, dataAttachment("volume-id2")}},
+			maxAttachments: 0,
+		}, {
+			testCaseName:      "Below the default limit",
+			maxAttachments:    2,
+			attachments:       &models.VolumeAttachmentList{VolumeAttachments: []models.VolumeAttachment{bootAttachment, dataAttachment("volume-id2")}},
+			expectedListCalls: 1,
+		}, {
+			testCaseName:      "Default limit reached, the boot volume is not counted",
+			maxAttachments:    2,
+			attachments:       &models.VolumeAttachmentList{VolumeAttachments: []models.VolumeAttachment{bootAttachment, dataAttachment("volume-id2"), dataAttachment("volume-id3")}},
+			expectedErr:       "InstanceAttachmentLimitReached",
+			expectedListCalls: 1,
+		}, {
+			testCaseName:             "Profile limit reached",
+			maxAttachments:           12,
+			maxAttachmentsPerProfile: map[string]int{"cx2-2x4": 1},
+			instance:                 &models.Instance{Profile: &models.Profile{Name: "cx2-2x4"}},
+			attachments:              &models.VolumeAttachmentList{VolumeAttachments: []models.VolumeAttachment{bootAttachment, dataAttachment("volume-id2")}},
+			expectedErr:              "InstanceAttachmentLimitReached",
+			expectedListCalls:        1,
+		}, {
+			testCaseName:             "Default limit applies to other profiles",
+			maxAttachments:           12,
+			maxAttachmentsPerProfile: map[string]int{"cx2-2x4": 1},
+			instance:                 &models.Instance{Profile: &models.Profile{Name: "bx2-2x8"}},
+			attachments:              &models.VolumeAttachmentList{VolumeAttachments: []models.VolumeAttachment{bootAttachment, dataAttachment("volume-id2")}},
+			expectedListCalls:        1,
+		}, {
+			testCaseName:      "Volume already attached to the full instance",
+			maxAttachments:    1,
+			attachments:       &models.VolumeAttachmentList{VolumeAttachments: []models.VolumeAttachment{bootAttachment, dataAttachment("volume-id1")}},
+			expectedListCalls: 1,
+		}, {
+			testCaseName:      "Attachments can not be listed",
+			maxAttachments:    1,
+			attachmentsErr:    &models.Error{Errors: []models.ErrorItem{{Code: models.ErrorCodeNotFound, Message: "instance not found"}}},
+			expectedListCalls: 1,
+		},
+	}
+
+	for _, testcase := range testCases {
+		t.Run(testcase.testCaseName, func(t *testing.T) {
+			vpcs, uc, sc, err := GetTestOpenSession(t, logger)
+			assert.NotNil(t, vpcs)
+			assert.NotNil(t, uc)
+			assert.NotNil(t, sc)
+			assert.Nil(t, err)
+
+			vpcs.Config.MaxVolumeAttachments = testcase.maxAttachments
+			vpcs.Config.MaxVolumeAttachmentsPerProfile = testcase.maxAttachmentsPerProfile
+			volumeAttachService := &instanceFakes.VolumeAttachService{}
+			volumeAttachService.WithContextReturns(volumeAttachService)
+			vpcs.APIClientVolAttachMgr = volumeAttachService
+			volumeAttachService.ListVolumeAttachmentsReturns(testcase.attachments, testcase.attachmentsErr)
+
+			volumeAttachment := models.NewVolumeAttachment(provider.VolumeAttachmentRequest{VolumeID: "volume-id1", InstanceID: instanceID})
+			err = vpcs.checkAttachmentLimit(volumeAttachment, testcase.instance)
+			if testcase.expectedErr != "" {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), testcase.expectedErr)
+				}
+			} else {
+				assert.Nil(t, err)
+			}
+			assert.Equal(t, testcase.expectedListCalls, volumeAttachService.ListVolumeAttachmentsCallCount())
+		})
+	}
+}
+
+func TestAttachVolumeAttachmentLimitReached(t *testing.T) {
+	//var err error
+	logger, teardown := GetTestLogger(t)
+	defer teardown()
+
+	vpcs, uc, sc, err := GetTestOpenSession(t, logger)
+	assert.NotNil(t, vpcs)
+	assert.NotNil(t, uc)
+	assert.NotNil(t, sc)
+	assert.Nil(t, err)
+
+	vpcs.Config.VPCConfig.IsIKS = false
+	vpcs.Config.MaxVolumeAttachments = 1
+	instanceService := &instanceFakes.InstanceService{}
+	uc.InstanceServiceReturns(instanceService)
+	instanceService.GetInstanceReturns(&models.Instance{ID: "instance-id1", Status: "running", Profile: &models.Profile{Name: "bx2-2x8"}}, nil)
+	volumeAttachService := &instanceFakes.VolumeAttachService{}
+	volumeAttachService.WithContextReturns(volumeAttachService)
+	vpcs.APIClientVolAttachMgr = volumeAttachService
+	volumeAttachService.ListVolumeAttachmentsReturns(&models.VolumeAttachmentList{VolumeAttachments: []models.VolumeAttachment{
+		{ID: "attachment-volume-id2", Type: "data", Volume: &models.Volume{ID: "volume-id2"}},
+	}}, nil)
+
+	volumeAttachment, err := vpcs.AttachVolume(provider.VolumeAttachmentRequest{VolumeID: "volume-id1", InstanceID: "instance-id1"})
+	assert.Nil(t, volumeAttachment)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "InstanceAttachmentLimitReached")
+	}
+	// The backend is not asked to attach the volume to the full instance
+	assert.Equal(t, 0, volumeAttachService.AttachVolumeCallCount())
+}
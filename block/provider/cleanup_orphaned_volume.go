@@ -0,0 +1,72 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package provider ...
+package provider
+
+import (
+	"github.com/IBM/ibmcloud-volume-interface/lib/provider"
+	vpcconfig "github.com/IBM/ibmcloud-volume-vpc/block/vpcconfig"
+	userError "github.com/IBM/ibmcloud-volume-vpc/common/messages"
+	"go.uber.org/zap"
+)
+
+const (
+	// orphanedVolumeTag is set on volumes which were ordered but did not become available
+	orphanedVolumeTag = "ibm-volume-lib-orphaned"
+)
+
+// cleanupOrphanedVolume applies the configured OrphanedVolumePolicy on a volume which was ordered but
+// did not become available, and returns the user error recording the action taken
+func (vpcs *VPCSession) cleanupOrphanedVolume(volumeID string, waitErr error) error {
+	policy := vpcconfig.OrphanedVolumeKeep
+	if vpcs.Config != nil {
+		policy = vpcs.Config.OrphanedVolumePolicy
+	}
+
+	var action string
+	switch policy {
+	case vpcconfig.OrphanedVolumeDelete:
+		vpcs.Logger.Info("Deleting the volume which did not get valid (available) state", zap.Reflect("VolumeID", volumeID))
+		err := vpcs.DeleteVolume(&provider.Volume{VolumeID: volumeID})
+		if err != nil {
+			vpcs.Logger.Error("Failed to delete the volume which did not get valid (available) state", zap.Reflect("VolumeID", volumeID), zap.Error(err))
+			action = "deletion failed, volume left as is"
+		} else {
+			action = "volume deleted"
+		}
+	case vpcconfig.OrphanedVolumeTag:
+		vpcs.Logger.Info("Tagging the volume which did not get valid (available) state", zap.Reflect("VolumeID", volumeID), zap.Reflect("Tag", orphanedVolumeTag))
+		var err error
+		err = vpcs.APIRetry.Retry(vpcs.GetContext(), vpcs.Logger, func() error {
+			err = vpcs.Apiclient.WithContext(vpcs.GetContext()).VolumeService().SetVolumeTag(volumeID, orphanedVolumeTag, vpcs.Logger)
+			return err
+		})
+		if err != nil {
+			vpcs.Logger.Error("Failed to tag the volume which did not get valid (available) state", zap.Reflect("VolumeID", volumeID), zap.Error(err))
+			action = "tagging failed, volume left as is"
+		} else {
+			action = "volume tagged as '" + orphanedVolumeTag + "'"
+		}
+	default:
+		if policy != vpcconfig.OrphanedVolumeKeep {
+			vpcs.Logger.Warn("Unknown OrphanedVolumePolicy, leaving the volume as is", zap.Reflect("OrphanedVolumePolicy", policy), zap.Reflect("VolumeID", volumeID))
+		}
+		return userError.GetUserError("VolumeNotInValidState", waitErr, volumeID)
+	}
+
+	return userError.GetUserError("VolumeNotInValidStateCleanup", waitErr, volumeID, action)
+}
@@ -0,0 +1,128 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package provider ...
+package provider
+
+import (
+	"testing"
+
+	vpcconfig "github.com/IBM/ibmcloud-volume-vpc/block/vpcconfig"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
+	volumeServiceFakes "github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/vpcvolume/fakes"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestCleanupOrphanedVolume(t *testing.T) {
+	//var err error
+	logger, teardown := GetTestLogger(t)
+	defer teardown()
+
+	var (
+		volumeService *volumeServiceFakes.VolumeService
+	)
+
+	notFoundErr := &models.Error{Errors: []models.ErrorItem{{Code: "volume_id_not_found", Message: "volume not found"}}}
+
+	testCases := []struct {
+		testCaseName string
+		policy       string
+		deleteErr    error
+		tagErr       error
+
+		expectedErr string
+
+		verify func(t *testing.T)
+	}{
+		{
+			testCaseName: "Volume kept by default",
+			policy:       vpcconfig.OrphanedVolumeKeep,
+			expectedErr:  "VolumeNotInValidState,",
+			verify: func(t *testing.T) {
+				assert.Equal(t, 0, volumeService.DeleteVolumeCallCount())
+				assert.Equal(t, 0, volumeService.SetVolumeTagCallCount())
+			},
+		}, {
+			testCaseName: "Volume kept for unknown policy",
+			policy:       "remove",
+			expectedErr:  "VolumeNotInValidState,",
+			verify: func(t *testing.T) {
+				assert.Equal(t, 0, volumeService.DeleteVolumeCallCount())
+				assert.Equal(t, 0, volumeService.SetVolumeTagCallCount())
+			},
+		}, {
+			testCaseName: "Volume deleted",
+			policy:       vpcconfig.OrphanedVolumeDelete,
+			expectedErr:  "Cleanup action taken: volume deleted",
+			verify: func(t *testing.T) {
+				assert.Equal(t, 1, volumeService.DeleteVolumeCallCount())
+			},
+		}, {
+			testCaseName: "Volume deletion failed",
+			policy:       vpcconfig.OrphanedVolumeDelete,
+			deleteErr:    notFoundErr,
+			expectedErr:  "Cleanup action taken: deletion failed",
+		}, {
+			testCaseName: "Volume tagged",
+			policy:       vpcconfig.OrphanedVolumeTag,
+			expectedErr:  "Cleanup action taken: volume tagged as 'ibm-volume-lib-orphaned'",
+			verify: func(t *testing.T) {
+				assert.Equal(t, 0, volumeService.DeleteVolumeCallCount())
+				volumeID, tagName, _ := volumeService.SetVolumeTagArgsForCall(0)
+				assert.Equal(t, "16f293bf-test-4bff-816f-e199c0c65db5", volumeID)
+				assert.Equal(t, "ibm-volume-lib-orphaned", tagName)
+			},
+		}, {
+			testCaseName: "Volume tagging failed",
+			policy:       vpcconfig.OrphanedVolumeTag,
+			tagErr:       notFoundErr,
+			expectedErr:  "Cleanup action taken: tagging failed",
+		},
+	}
+
+	for _, testcase := range testCases {
+		t.Run(testcase.testCaseName, func(t *testing.T) {
+			vpcs, uc, sc, err := GetTestOpenSession(t, logger)
+			assert.NotNil(t, vpcs)
+			assert.NotNil(t, uc)
+			assert.NotNil(t, sc)
+			assert.Nil(t, err)
+
+			volumeService = &volumeServiceFakes.VolumeService{}
+			assert.NotNil(t, volumeService)
+			uc.VolumeServiceReturns(volumeService)
+
+			vpcs.Config.OrphanedVolumePolicy = testcase.policy
+			volumeService.DeleteVolumeReturns(testcase.deleteErr)
+			// Volume is found by the checks before deletion, and reported as gone once deleted
+			volumeService.GetVolumeReturnsOnCall(0, &models.Volume{ID: "16f293bf-test-4bff-816f-e199c0c65db5"}, nil)
+			volumeService.GetVolumeReturns(nil, notFoundErr)
+			volumeService.SetVolumeTagReturns(testcase.tagErr)
+
+			err = vpcs.cleanupOrphanedVolume("16f293bf-test-4bff-816f-e199c0c65db5", nil)
+			logger.Info("Error details", zap.Reflect("Error details", err))
+
+			if assert.NotNil(t, err) {
+				assert.Contains(t, err.Error(), testcase.expectedErr)
+			}
+
+			if testcase.verify != nil {
+				testcase.verify(t)
+			}
+		})
+	}
+}
@@ -40,8 +40,8 @@ func (vpcs *VPCSession) CreateSnapshot(volumeRequest *provider.Volume, tags map[
 	vpcs.Logger.Info("Requested volume is:", zap.Reflect("Volume", volumeRequest))
 
 	var volume *models.Volume
-	err = retry(vpcs.Logger, func() error {
-		volume, err = vpcs.Apiclient.VolumeService().GetVolume(volumeRequest.VolumeID, vpcs.Logger)
+	err = vpcs.APIRetry.Retry(vpcs.GetContext(), vpcs.Logger, func() error {
+		volume, err = vpcs.Apiclient.WithContext(vpcs.GetContext()).VolumeService().GetVolume(volumeRequest.VolumeID, vpcs.Logger)
 		return err
 	})
 	if err != nil {
@@ -52,8 +52,17 @@ func (vpcs *VPCSession) CreateSnapshot(volumeRequest *provider.Volume, tags map[
 		return nil, userError.GetUserError("StorageFindFailedWithVolumeId", err, volumeRequest.VolumeID, "Not a valid volume ID")
 	}
 
-	err = retry(vpcs.Logger, func() error {
-		snapshot, err = vpcs.Apiclient.SnapshotService().CreateSnapshot(volumeRequest.VolumeID, snapshot, vpcs.Logger)
+	snapshotTemplate := newSnapshotTemplate(*volumeRequest, volume, tags)
+	// RIaaS accepts the resource group ID only
+	if snapshotTemplate.ResourceGroup != nil && len(snapshotTemplate.ResourceGroup.ID) == 0 {
+		snapshotTemplate.ResourceGroup.ID, err = resolveResourceGroupID(vpcs.GetContext(), vpcs.APIRetry, vpcs.Apiclient.WithContext(vpcs.GetContext()).ResourceGroupService(), snapshotTemplate.ResourceGroup.Name, vpcs.VPCAccountID, vpcs.Logger)
+		if err != nil {
+			return nil, err
+		}
+	}
+	vpcs.Logger.Info("Calling VPC provider for snapshot creation...", zap.Reflect("SnapshotTemplate", snapshotTemplate))
+	err = vpcs.APIRetry.Retry(vpcs.GetContext(), vpcs.Logger, func() error {
+		snapshot, err = vpcs.Apiclient.WithContext(vpcs.GetContext()).SnapshotService().CreateSnapshot(volumeRequest.VolumeID, snapshotTemplate, vpcs.Logger)
 		return err
 	})
 	if err != nil {
@@ -63,16 +72,39 @@ func (vpcs *VPCSession) CreateSnapshot(volumeRequest *provider.Volume, tags map[
 	vpcs.Logger.Info("Successfully created snapshot with backend (vpcclient) call")
 	vpcs.Logger.Info("Backend created snapshot details", zap.Reflect("Snapshot", snapshot))
 
+	vpcs.Logger.Info("Waiting for snapshot to be in stable state", zap.Reflect("SnapshotID", snapshot.ID))
+	snapshot, err = WaitForSnapshotStable(vpcs, snapshot.ID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Converting volume to lib volume type
 	volumeResponse := FromProviderToLibVolume(volume, vpcs.Logger)
 	if volumeResponse != nil {
-		respSnapshot := &provider.Snapshot{
-			Volume:               *volumeResponse,
-			SnapshotID:           snapshot.ID,
-			SnapshotCreationTime: *snapshot.CreatedAt,
-		}
+		respSnapshot := FromProviderToLibSnapshot(snapshot, vpcs.Logger)
+		respSnapshot.Volume = *volumeResponse
+		respSnapshot.SnapshotTags = tags
 		return respSnapshot, nil
 	}
 
 	return nil, userError.GetUserError("CoversionNotSuccessful", err, "Not able to prepare provider volume")
 }
+
+// newSnapshotTemplate builds the snapshot to order from the request. The snapshot name is taken from the
+// models.SnapshotNameAttribute attribute, the resource group defaults to the one of the source volume.
+// A resource group given by name only is to be resolved to its ID by the caller
+func newSnapshotTemplate(volumeRequest provider.Volume, volume *models.Volume, tags map[string]string) *models.Snapshot {
+	snapshotTemplate := &models.Snapshot{
+		Name: volumeRequest.Attributes[models.SnapshotNameAttribute],
+		Tags: toTagList(tags),
+	}
+	if volumeRequest.VPCVolume.ResourceGroup != nil && (len(volumeRequest.VPCVolume.ResourceGroup.ID) > 0 || len(volumeRequest.VPCVolume.ResourceGroup.Name) > 0) {
+		snapshotTemplate.ResourceGroup = &models.ResourceGroup{
+			ID:   volumeRequest.VPCVolume.ResourceGroup.ID,
+			Name: volumeRequest.VPCVolume.ResourceGroup.Name,
+		}
+	} else if volume != nil && volume.ResourceGroup != nil {
+		snapshotTemplate.ResourceGroup = &models.ResourceGroup{ID: volume.ResourceGroup.ID}
+	}
+	return snapshotTemplate
+}
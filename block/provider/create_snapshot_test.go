@@ -26,6 +26,7 @@ import (
 	util "github.com/IBM/ibmcloud-volume-interface/lib/utils"
 	"github.com/IBM/ibmcloud-volume-interface/lib/utils/reasoncode"
 	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
+	resourceManagerFakes "github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/resourcemanager/fakes"
 	serviceFakes "github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/vpcvolume/fakes"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
@@ -71,11 +72,14 @@ func TestCreateSnapshot(t *testing.T) {
 			baseSnapshot: &models.Snapshot{
 				ID:     "16f293bf-test-4bff-816f-e199c0c65db5",
 				Name:   "test snapshot name",
-				Status: models.StatusType("OK"),
+				Status: models.StatusType("stable"),
 			},
 			verify: func(t *testing.T, snapshotResponse *provider.Snapshot, err error) {
-				assert.Nil(t, snapshotResponse)
-				assert.NotNil(t, err)
+				assert.Nil(t, err)
+				if assert.NotNil(t, snapshotResponse) {
+					assert.Equal(t, "16f293bf-test-4bff-816f-e199c0c65db5", snapshotResponse.VolumeID)
+					assert.Equal(t, "", snapshotResponse.Az)
+				}
 			},
 		}, {
 			testCaseName: "Not supported yet",
@@ -152,11 +156,14 @@ func TestCreateSnapshot(t *testing.T) {
 			baseSnapshot: &models.Snapshot{
 				ID:     "16f293bf-test-4bff-816f-e199c0c65db5",
 				Name:   "test-snapshot-name",
-				Status: models.StatusType("OK"),
+				Status: models.StatusType("stable"),
 			},
 			verify: func(t *testing.T, snapshotResponse *provider.Snapshot, err error) {
-				assert.Nil(t, snapshotResponse)
-				assert.NotNil(t, err)
+				assert.Nil(t, err)
+				if assert.NotNil(t, snapshotResponse) {
+					assert.Equal(t, "16f293bf-test-4bff-816f-e199c0c65db5", snapshotResponse.SnapshotID)
+					assert.Equal(t, "test volume name", *snapshotResponse.Name)
+				}
 			},
 		},
 	}
@@ -177,6 +184,8 @@ func TestCreateSnapshot(t *testing.T) {
 			assert.NotNil(t, volumeService)
 			uc.VolumeServiceReturns(volumeService)
 
+			snapshotService.GetSnapshotByIDReturns(testcase.baseSnapshot, nil)
+
 			if testcase.skipErrTest == true {
 				snapshotService.CreateSnapshotReturns(testcase.baseSnapshot, nil)
 				volumeService.GetVolumeReturns(testcase.baseVolume, errors.New("errorUnclassified"))
@@ -236,7 +245,7 @@ func TestCreateSnapshotTwo(t *testing.T) {
 	baseSnapshot = &models.Snapshot{
 		ID:        "16f293bf-test-4bff-816f-e199c0c65db5",
 		Name:      "test-snapshot-name",
-		Status:    models.StatusType("OK"),
+		Status:    models.StatusType("stable"),
 		CreatedAt: &timeNow,
 	}
 	vpcs, uc, sc, err := GetTestOpenSession(t, logger)
@@ -263,6 +272,7 @@ func TestCreateSnapshotTwo(t *testing.T) {
 	assert.NotNil(t, err)
 
 	snapshotService.CreateSnapshotReturns(baseSnapshot, nil)
+	snapshotService.GetSnapshotByIDReturns(baseSnapshot, nil)
 	volumeService.GetVolumeReturns(baseVolume, nil)
 
 	snapshot, err = vpcs.CreateSnapshot(providerVolume, tags)
@@ -271,3 +281,153 @@ func TestCreateSnapshotTwo(t *testing.T) {
 	assert.NotNil(t, snapshot)
 	assert.Nil(t, err)
 }
+
+func TestCreateSnapshotWithNameAndTags(t *testing.T) {
+	//var err error
+	logger, teardown := GetTestLogger(t)
+	defer teardown()
+
+	var (
+		snapshotService *serviceFakes.SnapshotService
+		volumeService   *serviceFakes.VolumeService
+	)
+
+	baseVolume := &models.Volume{
+		ID:            "16f293bf-test-4bff-816f-e199c0c65db5",
+		Name:          "test volume name",
+		Status:        models.StatusType("available"),
+		Capacity:      int64(10),
+		Zone:          &models.Zone{Name: "test-zone"},
+		ResourceGroup: &models.ResourceGroup{ID: "volume resource group id"},
+	}
+
+	testCases := []struct {
+		testCaseName   string
+		providerVolume *provider.Volume
+		tags           map[string]string
+		resourceGroup  *models.ResourceGroup
+		snapshotStatus string
+
+		verify func(t *testing.T, snapshotResponse *provider.Snapshot, err error)
+	}{
+		{
+			testCaseName: "Snapshot created with name, resource group and tags",
+			providerVolume: &provider.Volume{
+				VolumeID:   "16f293bf-test-4bff-816f-e199c0c65db5",
+				Attributes: map[string]string{models.SnapshotNameAttribute: "nightly-backup-1"},
+				VPCVolume: provider.VPCVolume{
+					ResourceGroup: &provider.ResourceGroup{ID: "default resource group id"},
+				},
+			},
+			tags:           map[string]string{"job": "nightly", "backup": "daily"},
+			snapshotStatus: "stable",
+			verify: func(t *testing.T, snapshotResponse *provider.Snapshot, err error) {
+				assert.Nil(t, err)
+				if assert.NotNil(t, snapshotResponse) {
+					assert.Equal(t, "s6f293bf-test-4bff-816f-e199c0c65db5", snapshotResponse.SnapshotID)
+					assert.Equal(t, "daily", snapshotResponse.SnapshotTags["backup"])
+				}
+				volumeID, snapshotTemplate, _ := snapshotService.CreateSnapshotArgsForCall(0)
+				assert.Equal(t, "16f293bf-test-4bff-816f-e199c0c65db5", volumeID)
+				assert.Equal(t, "nightly-backup-1", snapshotTemplate.Name)
+				assert.Equal(t, "default resource group id", snapshotTemplate.ResourceGroup.ID)
+				assert.Equal(t, []string{"backup:daily", "job:nightly"}, snapshotTemplate.Tags)
+			},
+		}, {
+			testCaseName: "Snapshot created in the resource group of the given name",
+			providerVolume: &provider.Volume{
+				VolumeID: "16f293bf-test-4bff-816f-e199c0c65db5",
+				VPCVolume: provider.VPCVolume{
+					ResourceGroup: &provider.ResourceGroup{Name: "default"},
+				},
+			},
+			resourceGroup:  &models.ResourceGroup{ID: "default resource group id", Name: "default"},
+			snapshotStatus: "stable",
+			verify: func(t *testing.T, snapshotResponse *provider.Snapshot, err error) {
+				assert.Nil(t, err)
+				assert.NotNil(t, snapshotResponse)
+				_, snapshotTemplate, _ := snapshotService.CreateSnapshotArgsForCall(0)
+				assert.Equal(t, "default resource group id", snapshotTemplate.ResourceGroup.ID)
+			},
+		}, {
+			testCaseName: "Resource group of the given name not found",
+			providerVolume: &provider.Volume{
+				VolumeID: "16f293bf-test-4bff-816f-e199c0c65db5",
+				VPCVolume: provider.VPCVolume{
+					ResourceGroup: &provider.ResourceGroup{Name: "unknown"},
+				},
+			},
+			resourceGroup:  &models.ResourceGroup{},
+			snapshotStatus: "stable",
+			verify: func(t *testing.T, snapshotResponse *provider.Snapshot, err error) {
+				assert.Nil(t, snapshotResponse)
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), "ResourceGroupNotFound")
+				}
+				assert.Equal(t, 0, snapshotService.CreateSnapshotCallCount())
+			},
+		}, {
+			testCaseName: "Snapshot created in the resource group of the volume",
+			providerVolume: &provider.Volume{
+				VolumeID: "16f293bf-test-4bff-816f-e199c0c65db5",
+			},
+			snapshotStatus: "stable",
+			verify: func(t *testing.T, snapshotResponse *provider.Snapshot, err error) {
+				assert.Nil(t, err)
+				assert.NotNil(t, snapshotResponse)
+				_, snapshotTemplate, _ := snapshotService.CreateSnapshotArgsForCall(0)
+				assert.Equal(t, "", snapshotTemplate.Name)
+				assert.Equal(t, "volume resource group id", snapshotTemplate.ResourceGroup.ID)
+				assert.Empty(t, snapshotTemplate.Tags)
+			},
+		}, {
+			testCaseName: "Snapshot creation failed in the backend",
+			providerVolume: &provider.Volume{
+				VolumeID: "16f293bf-test-4bff-816f-e199c0c65db5",
+			},
+			snapshotStatus: "failed",
+			verify: func(t *testing.T, snapshotResponse *provider.Snapshot, err error) {
+				assert.Nil(t, snapshotResponse)
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), "SnapshotNotInStableState")
+				}
+				assert.Equal(t, 1, snapshotService.GetSnapshotByIDCallCount())
+			},
+		},
+	}
+
+	for _, testcase := range testCases {
+		t.Run(testcase.testCaseName, func(t *testing.T) {
+			vpcs, uc, sc, err := GetTestOpenSession(t, logger)
+			assert.NotNil(t, vpcs)
+			assert.NotNil(t, uc)
+			assert.NotNil(t, sc)
+			assert.Nil(t, err)
+
+			snapshotService = &serviceFakes.SnapshotService{}
+			assert.NotNil(t, snapshotService)
+			uc.SnapshotServiceReturns(snapshotService)
+
+			volumeService = &serviceFakes.VolumeService{}
+			assert.NotNil(t, volumeService)
+			uc.VolumeServiceReturns(volumeService)
+
+			if testcase.resourceGroup != nil {
+				resourceGroupService := &resourceManagerFakes.ResourceGroupService{}
+				resourceGroupService.GetResourceGroupByNameReturns(testcase.resourceGroup, nil)
+				uc.ResourceGroupServiceReturns(resourceGroupService)
+			}
+
+			volumeService.GetVolumeReturns(baseVolume, nil)
+			snapshotService.CreateSnapshotReturns(&models.Snapshot{ID: "s6f293bf-test-4bff-816f-e199c0c65db5", Status: models.StatusType("pending")}, nil)
+			snapshotService.GetSnapshotByIDReturns(&models.Snapshot{ID: "s6f293bf-test-4bff-816f-e199c0c65db5", Status: models.StatusType(testcase.snapshotStatus)}, nil)
+
+			snapshot, err := vpcs.CreateSnapshot(testcase.providerVolume, testcase.tags)
+			logger.Info("Snapshot details", zap.Reflect("snapshot", snapshot))
+
+			if testcase.verify != nil {
+				testcase.verify(t, snapshot, err)
+			}
+		})
+	}
+}
@@ -43,6 +43,20 @@ func (vpcs *VPCSession) CreateVolume(volumeRequest provider.Volume) (volumeRespo
 	if err != nil {
 		return nil, err
 	}
+	err = validateVolumeProfile(vpcs, volumeRequest.VPCVolume.Profile.Name, int64(*volumeRequest.Capacity), iops)
+	if err != nil {
+		return nil, err
+	}
+	err = validateZone(vpcs, volumeRequest.Az)
+	if err != nil {
+		return nil, err
+	}
+	if len(resourceGroup.ID) == 0 {
+		resourceGroup.ID, err = resolveResourceGroupID(vpcs.GetContext(), vpcs.APIRetry, vpcs.Apiclient.WithContext(vpcs.GetContext()).ResourceGroupService(), resourceGroup.Name, vpcs.VPCAccountID, vpcs.Logger)
+		if err != nil {
+			return nil, err
+		}
+	}
 	vpcs.Logger.Info("Successfully validated inputs for CreateVolume request... ")
 
 	// Build the template to send to backend
@@ -66,24 +80,50 @@ func (vpcs *VPCSession) CreateVolume(volumeRequest provider.Volume) (volumeRespo
 		volumeTemplate.VolumeEncryptionKey = &models.VolumeEncryptionKey{CRN: encryptionKeyCRN}
 	}
 
-	vpcs.Logger.Info("Calling VPC provider for volume creation...")
+	// A previous attempt may have already ordered the volume, look it up by name before placing a new order
+	vpcs.Logger.Info("Checking if the volume already exists...", zap.Reflect("VolumeName", volumeTemplate.Name))
 	var volume *models.Volume
-	err = retry(vpcs.Logger, func() error {
-		volume, err = vpcs.Apiclient.VolumeService().CreateVolume(volumeTemplate, vpcs.Logger)
+	// Only a volume ordered by this call is cleaned up if it does not become available
+	var isOrderedByThisCall bool
+	err = vpcs.APIRetry.Retry(vpcs.GetContext(), vpcs.Logger, func() error {
+		volume, err = vpcs.Apiclient.WithContext(vpcs.GetContext()).VolumeService().GetVolumeByName(volumeTemplate.Name, vpcs.Logger)
 		return err
 	})
 
 	if err != nil {
-		vpcs.Logger.Debug("Failed to create volume from VPC provider", zap.Reflect("BackendError", err))
+		vpcs.Logger.Debug("Failed to look up volume by name from VPC provider", zap.Reflect("BackendError", err))
 		return nil, userError.GetUserError("FailedToPlaceOrder", err)
 	}
 
-	vpcs.Logger.Info("Successfully created volume from VPC provider...", zap.Reflect("VolumeDetails", volume))
+	if volume != nil {
+		if !isVolumeMatchingTemplate(volume, volumeTemplate) {
+			vpcs.Logger.Info("Volume already exists with different parameters", zap.Reflect("VolumeDetails", volume))
+			return nil, userError.GetUserError("VolumeNameConflict", nil, volumeTemplate.Name, volume.ID)
+		}
+		vpcs.Logger.Info("Volume already exists with the requested parameters, reusing it", zap.Reflect("VolumeDetails", volume))
+	} else {
+		vpcs.Logger.Info("Calling VPC provider for volume creation...")
+		err = vpcs.APIRetry.Retry(vpcs.GetContext(), vpcs.Logger, func() error {
+			volume, err = vpcs.Apiclient.WithContext(vpcs.GetContext()).VolumeService().CreateVolume(volumeTemplate, vpcs.Logger)
+			return err
+		})
+
+		if err != nil {
+			vpcs.Logger.Debug("Failed to create volume from VPC provider", zap.Reflect("BackendError", err))
+			return nil, userError.GetUserError("FailedToPlaceOrder", err)
+		}
+
+		isOrderedByThisCall = true
+		vpcs.Logger.Info("Successfully created volume from VPC provider...", zap.Reflect("VolumeDetails", volume))
+	}
 
 	vpcs.Logger.Info("Waiting for volume to be in valid (available) state", zap.Reflect("VolumeDetails", volume))
 	err = WaitForValidVolumeState(vpcs, volume.ID)
 	if err != nil {
-		return nil, userError.GetUserError("VolumeNotInValidState", err, volume.ID)
+		if !isOrderedByThisCall {
+			return nil, err
+		}
+		return nil, vpcs.cleanupOrphanedVolume(volume.ID, err)
 	}
 	vpcs.Logger.Info("Volume got valid (available) state", zap.Reflect("VolumeDetails", volume))
 
@@ -99,6 +139,46 @@ func (vpcs *VPCSession) CreateVolume(volumeRequest provider.Volume) (volumeRespo
 	return volumeResponse, err
 }
 
+// isVolumeMatchingTemplate checks if an existing volume has the zone, capacity, profile, IOPS, resource group and encryption key of the template
+func isVolumeMatchingTemplate(volume *models.Volume, volumeTemplate *models.Volume) bool {
+	if volume.Zone == nil || volume.Zone.Name != volumeTemplate.Zone.Name {
+		return false
+	}
+
+	if volume.Capacity != volumeTemplate.Capacity {
+		return false
+	}
+
+	if volume.Profile == nil || volume.Profile.Name != volumeTemplate.Profile.Name {
+		return false
+	}
+
+	// IOPS is only requested for the custom profile, tiered profiles derive it from the capacity
+	if volumeTemplate.Iops > 0 && volume.Iops != volumeTemplate.Iops {
+		return false
+	}
+
+	// Resource group may be requested either by ID or by name
+	if volumeTemplate.ResourceGroup != nil && (len(volumeTemplate.ResourceGroup.ID) > 0 || len(volumeTemplate.ResourceGroup.Name) > 0) {
+		if volume.ResourceGroup == nil {
+			return false
+		}
+		if len(volumeTemplate.ResourceGroup.ID) > 0 && volume.ResourceGroup.ID != volumeTemplate.ResourceGroup.ID {
+			return false
+		}
+		if len(volumeTemplate.ResourceGroup.ID) == 0 && len(volume.ResourceGroup.Name) > 0 && volume.ResourceGroup.Name != volumeTemplate.ResourceGroup.Name {
+			return false
+		}
+	}
+
+	if volumeTemplate.VolumeEncryptionKey != nil {
+		if volume.VolumeEncryptionKey == nil || volume.VolumeEncryptionKey.CRN != volumeTemplate.VolumeEncryptionKey.CRN {
+			return false
+		}
+	}
+	return true
+}
+
 // validateVolumeRequest validating volume request
 func validateVolumeRequest(volumeRequest provider.Volume) (models.ResourceGroup, int64, error) {
 	resourceGroup := models.ResourceGroup{}
@@ -140,7 +220,7 @@ func validateVolumeRequest(volumeRequest provider.Volume) (models.ResourceGroup,
 		resourceGroup.ID = volumeRequest.VPCVolume.ResourceGroup.ID
 	}
 	if len(volumeRequest.VPCVolume.ResourceGroup.Name) > 0 {
-		// get the resource group ID from resource group name as Name is not supported by RIaaS
+		// The resource group ID is resolved from the name by the caller, as Name is not supported by RIaaS
 		resourceGroup.Name = volumeRequest.VPCVolume.ResourceGroup.Name
 	}
 	return resourceGroup, iops, nil
@@ -18,14 +18,120 @@
 package provider
 
 import (
+	"time"
+
+	"github.com/IBM/ibmcloud-volume-interface/lib/metrics"
 	"github.com/IBM/ibmcloud-volume-interface/lib/provider"
+	"github.com/IBM/ibmcloud-volume-interface/lib/utils/reasoncode"
+	userError "github.com/IBM/ibmcloud-volume-vpc/common/messages"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
 	"go.uber.org/zap"
 )
 
-// CreateVolumeFromSnapshot creates the volume by using ID
+// CreateVolumeFromSnapshot creates the volume by restoring the given snapshot
 func (vpcs *VPCSession) CreateVolumeFromSnapshot(snapshot provider.Snapshot, tags map[string]string) (*provider.Volume, error) {
 	vpcs.Logger.Info("Entry CreateVolumeFromSnapshot", zap.Reflect("Snapshot", snapshot))
 	defer vpcs.Logger.Info("Exit CreateVolumeFromSnapshot", zap.Reflect("Snapshot", snapshot))
+	defer metrics.UpdateDurationFromStart(vpcs.Logger, "CreateVolumeFromSnapshot", time.Now())
+
+	// Volume details to be created are taken from the snapshot's volume
+	volumeRequest := snapshot.Volume
+
+	vpcs.Logger.Info("Basic validation for CreateVolumeFromSnapshot request... ", zap.Reflect("RequestedVolumeDetails", volumeRequest))
+	if len(snapshot.SnapshotID) == 0 {
+		return nil, userError.GetUserError(string(reasoncode.ErrorRequiredFieldMissing), nil, "SnapshotID")
+	}
+	// Profile is checked first as validateVolumeRequest relies on it
+	if volumeRequest.VPCVolume.Profile == nil || len(volumeRequest.VPCVolume.Profile.Name) == 0 {
+		return nil, userError.GetUserError(string(reasoncode.ErrorRequiredFieldMissing), nil, "Profile")
+	}
+	if len(volumeRequest.Az) == 0 {
+		return nil, userError.GetUserError(string(reasoncode.ErrorRequiredFieldMissing), nil, "Az")
+	}
+	resourceGroup, iops, err := validateVolumeRequest(volumeRequest)
+	if err != nil {
+		return nil, err
+	}
+	err = validateVolumeProfile(vpcs, volumeRequest.VPCVolume.Profile.Name, int64(*volumeRequest.Capacity), iops)
+	if err != nil {
+		return nil, err
+	}
+	err = validateZone(vpcs, volumeRequest.Az)
+	if err != nil {
+		return nil, err
+	}
+	if len(resourceGroup.ID) == 0 {
+		resourceGroup.ID, err = resolveResourceGroupID(vpcs.GetContext(), vpcs.APIRetry, vpcs.Apiclient.WithContext(vpcs.GetContext()).ResourceGroupService(), resourceGroup.Name, vpcs.VPCAccountID, vpcs.Logger)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// The restored volume can not be smaller than the snapshot
+	sourceSnapshot, err := vpcs.GetSnapshot(snapshot.SnapshotID)
+	if err != nil {
+		return nil, err
+	}
+	if sourceSnapshot != nil && sourceSnapshot.SnapshotSize != nil && *volumeRequest.Capacity < *sourceSnapshot.SnapshotSize {
+		return nil, userError.GetUserError("VolumeCapacityBelowSnapshotSize", nil, *volumeRequest.Capacity, *sourceSnapshot.SnapshotSize, snapshot.SnapshotID)
+	}
+	vpcs.Logger.Info("Successfully validated inputs for CreateVolumeFromSnapshot request... ")
+
+	// The tags passed along with the snapshot are applied on top of the requested volume tags
+	volumeTags := append([]string{}, volumeRequest.VPCVolume.Tags...)
+	volumeTags = append(volumeTags, toTagList(tags)...)
+
+	// Build the template to send to backend
+	volumeTemplate := &models.Volume{
+		Name:          *volumeRequest.Name,
+		Capacity:      int64(*volumeRequest.Capacity),
+		Iops:          iops,
+		Tags:          volumeTags,
+		ResourceGroup: &resourceGroup,
+		Profile: &models.Profile{
+			Name: volumeRequest.VPCVolume.Profile.Name,
+		},
+		Zone: &models.Zone{
+			Name: volumeRequest.Az,
+		},
+		Snapshot: &models.Snapshot{
+			ID: snapshot.SnapshotID,
+		},
+	}
+
+	if volumeRequest.VPCVolume.VolumeEncryptionKey != nil && len(volumeRequest.VPCVolume.VolumeEncryptionKey.CRN) > 0 {
+		volumeTemplate.VolumeEncryptionKey = &models.VolumeEncryptionKey{CRN: volumeRequest.VPCVolume.VolumeEncryptionKey.CRN}
+	}
+
+	vpcs.Logger.Info("Calling VPC provider for volume creation from snapshot...")
+	var volume *models.Volume
+	err = vpcs.APIRetry.Retry(vpcs.GetContext(), vpcs.Logger, func() error {
+		volume, err = vpcs.Apiclient.WithContext(vpcs.GetContext()).VolumeService().CreateVolume(volumeTemplate, vpcs.Logger)
+		return err
+	})
+
+	if err != nil {
+		vpcs.Logger.Debug("Failed to create volume from snapshot", zap.Reflect("BackendError", err))
+		return nil, userError.GetUserError("FailedToRestoreSnapshot", err, snapshot.SnapshotID)
+	}
+
+	vpcs.Logger.Info("Successfully created volume from snapshot...", zap.Reflect("VolumeDetails", volume))
+
+	vpcs.Logger.Info("Waiting for volume to be in valid (available) state", zap.Reflect("VolumeDetails", volume))
+	err = WaitForValidVolumeState(vpcs, volume.ID)
+	if err != nil {
+		return nil, vpcs.cleanupOrphanedVolume(volume.ID, err)
+	}
+	vpcs.Logger.Info("Volume got valid (available) state", zap.Reflect("VolumeDetails", volume))
 
-	return nil, nil
+	// Converting volume to lib volume type
+	volumeResponse := FromProviderToLibVolume(volume, vpcs.Logger)
+	// VPC does have region yet . So use requested region in response
+	volumeResponse.Region = volumeRequest.Region
+	// Return reuested tag as is if not tags returned by backend
+	if len(volumeResponse.Tags) == 0 && len(volumeRequest.Tags) > 0 {
+		volumeResponse.Tags = volumeRequest.Tags
+	}
+	vpcs.Logger.Info("VolumeResponse", zap.Reflect("volumeResponse", volumeResponse))
+	return volumeResponse, nil
 }
@@ -18,11 +18,15 @@
 package provider
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/IBM/ibmcloud-volume-interface/lib/provider"
 	util "github.com/IBM/ibmcloud-volume-interface/lib/utils"
 	"github.com/IBM/ibmcloud-volume-interface/lib/utils/reasoncode"
+	vpcconfig "github.com/IBM/ibmcloud-volume-vpc/block/vpcconfig"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
+	volumeServiceFakes "github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/vpcvolume/fakes"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
 )
@@ -32,11 +36,23 @@ func TestCreateVolumeFromSnapshot(t *testing.T) {
 	logger, teardown := GetTestLogger(t)
 	defer teardown()
 
+	var (
+		volumeService   *volumeServiceFakes.VolumeService
+		snapshotService *volumeServiceFakes.SnapshotService
+	)
+
 	testCases := []struct {
 		testCaseName string
 		baseSnapshot *provider.Snapshot
+		baseVolume   *models.Volume
+
+		sourceSnapshot *models.Snapshot
 
-		tags map[string]string
+		tags         map[string]string
+		expectedTags []string
+
+		policy  string
+		waitErr error
 
 		setup func()
 
@@ -47,16 +63,174 @@ func TestCreateVolumeFromSnapshot(t *testing.T) {
 		verify func(t *testing.T, volumes *provider.Volume, err error)
 	}{
 		{
-			testCaseName: "Not supported",
+			testCaseName: "Snapshot ID is empty",
+			baseSnapshot: &provider.Snapshot{
+				Volume: provider.Volume{
+					Name:     String("test-volume-name"),
+					Capacity: Int(10),
+				},
+			},
+			verify: func(t *testing.T, volume *provider.Volume, err error) {
+				assert.Nil(t, volume)
+				assert.NotNil(t, err)
+			},
+		}, {
+			testCaseName: "Volume capacity is invalid",
 			baseSnapshot: &provider.Snapshot{
 				SnapshotID: "16f293bf-test-4bff-816f-e199c0c65db5",
+				Volume: provider.Volume{
+					Name:     String("test-volume-name"),
+					Capacity: Int(5),
+				},
 			},
-			tags: map[string]string{
-				"dev": "snapshot1",
+			verify: func(t *testing.T, volume *provider.Volume, err error) {
+				assert.Nil(t, volume)
+				assert.NotNil(t, err)
+			},
+		}, {
+			testCaseName: "Volume profile is empty",
+			baseSnapshot: &provider.Snapshot{
+				SnapshotID: "16f293bf-test-4bff-816f-e199c0c65db5",
+				Volume: provider.Volume{
+					Name:     String("test-volume-name"),
+					Capacity: Int(10),
+					Az:       "test-zone",
+				},
+			},
+			verify: func(t *testing.T, volume *provider.Volume, err error) {
+				assert.Nil(t, volume)
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), "Profile")
+				}
+			},
+		}, {
+			testCaseName: "Volume capacity smaller than snapshot size",
+			baseSnapshot: &provider.Snapshot{
+				SnapshotID: "16f293bf-test-4bff-816f-e199c0c65db5",
+				Volume: provider.Volume{
+					Name:     String("test-volume-name"),
+					Capacity: Int(10),
+					Az:       "test-zone",
+					VPCVolume: provider.VPCVolume{
+						Profile:       &provider.Profile{Name: "general-purpose"},
+						ResourceGroup: &provider.ResourceGroup{ID: "default resource group id"},
+					},
+				},
+			},
+			sourceSnapshot: &models.Snapshot{
+				ID:   "16f293bf-test-4bff-816f-e199c0c65db5",
+				Size: 20,
 			},
 			verify: func(t *testing.T, volume *provider.Volume, err error) {
 				assert.Nil(t, volume)
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), "VolumeCapacityBelowSnapshotSize")
+				}
+			},
+		}, {
+			testCaseName: "Volume zone is empty",
+			baseSnapshot: &provider.Snapshot{
+				SnapshotID: "16f293bf-test-4bff-816f-e199c0c65db5",
+				Volume: provider.Volume{
+					Name:     String("test-volume-name"),
+					Capacity: Int(10),
+					VPCVolume: provider.VPCVolume{
+						Profile:       &provider.Profile{Name: "general-purpose"},
+						ResourceGroup: &provider.ResourceGroup{ID: "default resource group id"},
+					},
+				},
+			},
+			verify: func(t *testing.T, volume *provider.Volume, err error) {
+				assert.Nil(t, volume)
+				assert.NotNil(t, err)
+			},
+		}, {
+			testCaseName: "Volume restored from snapshot",
+			baseSnapshot: &provider.Snapshot{
+				SnapshotID: "16f293bf-test-4bff-816f-e199c0c65db5",
+				Volume: provider.Volume{
+					Name:     String("test-volume-name"),
+					Capacity: Int(10),
+					Az:       "test-zone",
+					VPCVolume: provider.VPCVolume{
+						Profile:       &provider.Profile{Name: "general-purpose"},
+						ResourceGroup: &provider.ResourceGroup{ID: "default resource group id"},
+					},
+				},
+			},
+			baseVolume: &models.Volume{
+				ID:       "v6f293bf-test-4bff-816f-e199c0c65db5",
+				Name:     "test-volume-name",
+				Status:   models.StatusType("available"),
+				Capacity: int64(10),
+				Zone:     &models.Zone{Name: "test-zone"},
+				Snapshot: &models.Snapshot{ID: "16f293bf-test-4bff-816f-e199c0c65db5"},
+			},
+			sourceSnapshot: &models.Snapshot{
+				ID:   "16f293bf-test-4bff-816f-e199c0c65db5",
+				Size: 10,
+			},
+			tags: map[string]string{
+				"dev":  "snapshot1",
+				"team": "storage",
+			},
+			expectedTags: []string{"dev:snapshot1", "team:storage"},
+			verify: func(t *testing.T, volume *provider.Volume, err error) {
+				assert.NotNil(t, volume)
 				assert.Nil(t, err)
+				assert.Equal(t, "v6f293bf-test-4bff-816f-e199c0c65db5", volume.VolumeID)
+				assert.Equal(t, "test-zone", volume.Az)
+			},
+		}, {
+			testCaseName: "Volume restored from snapshot but not available is tagged",
+			policy:       vpcconfig.OrphanedVolumeTag,
+			waitErr:      &models.Error{Errors: []models.ErrorItem{{Code: "volume_id_not_found", Message: "volume not found"}}},
+			baseSnapshot: &provider.Snapshot{
+				SnapshotID: "16f293bf-test-4bff-816f-e199c0c65db5",
+				Volume: provider.Volume{
+					Name:     String("test-volume-name"),
+					Capacity: Int(10),
+					Az:       "test-zone",
+					VPCVolume: provider.VPCVolume{
+						Profile:       &provider.Profile{Name: "general-purpose"},
+						ResourceGroup: &provider.ResourceGroup{ID: "default resource group id"},
+					},
+				},
+			},
+			baseVolume: &models.Volume{
+				ID:       "v6f293bf-test-4bff-816f-e199c0c65db5",
+				Name:     "test-volume-name",
+				Status:   models.StatusType("pending"),
+				Capacity: int64(10),
+				Zone:     &models.Zone{Name: "test-zone"},
+			},
+			verify: func(t *testing.T, volume *provider.Volume, err error) {
+				assert.Nil(t, volume)
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), "Cleanup action taken: volume tagged as 'ibm-volume-lib-orphaned'")
+				}
+				volumeID, _, _ := volumeService.SetVolumeTagArgsForCall(0)
+				assert.Equal(t, "v6f293bf-test-4bff-816f-e199c0c65db5", volumeID)
+			},
+		}, {
+			testCaseName: "Volume creation from snapshot failed",
+			baseSnapshot: &provider.Snapshot{
+				SnapshotID: "16f293bf-test-4bff-816f-e199c0c65db5",
+				Volume: provider.Volume{
+					Name:     String("test-volume-name"),
+					Capacity: Int(10),
+					Az:       "test-zone",
+					VPCVolume: provider.VPCVolume{
+						Profile:       &provider.Profile{Name: "general-purpose"},
+						ResourceGroup: &provider.ResourceGroup{ID: "default resource group id"},
+					},
+				},
+			},
+			expectedErr:        "{Code:FailedToRestoreSnapshot, Type:ProvisioningFailed, Description:Failed to create volume from snapshot ID",
+			expectedReasonCode: "ErrorUnclassified",
+			verify: func(t *testing.T, volume *provider.Volume, err error) {
+				assert.Nil(t, volume)
+				assert.NotNil(t, err)
 			},
 		},
 	}
@@ -69,6 +243,25 @@ func TestCreateVolumeFromSnapshot(t *testing.T) {
 			assert.NotNil(t, sc)
 			assert.Nil(t, err)
 
+			volumeService = &volumeServiceFakes.VolumeService{}
+			assert.NotNil(t, volumeService)
+			uc.VolumeServiceReturns(volumeService)
+			snapshotService = &volumeServiceFakes.SnapshotService{}
+			assert.NotNil(t, snapshotService)
+			uc.SnapshotServiceReturns(snapshotService)
+			snapshotService.GetSnapshotByIDReturns(testcase.sourceSnapshot, nil)
+
+			if testcase.expectedErr != "" {
+				volumeService.CreateVolumeReturns(testcase.baseVolume, errors.New(testcase.expectedReasonCode))
+			} else {
+				volumeService.CreateVolumeReturns(testcase.baseVolume, nil)
+				volumeService.GetVolumeReturns(testcase.baseVolume, nil)
+			}
+			if testcase.waitErr != nil {
+				vpcs.Config.OrphanedVolumePolicy = testcase.policy
+				volumeService.GetVolumeReturns(nil, testcase.waitErr)
+			}
+
 			volume, err := vpcs.CreateVolumeFromSnapshot(*testcase.baseSnapshot, testcase.tags)
 			logger.Info("Volumes details", zap.Reflect("volumes", volume))
 
@@ -78,6 +271,10 @@ func TestCreateVolumeFromSnapshot(t *testing.T) {
 				assert.Equal(t, reasoncode.ReasonCode(testcase.expectedReasonCode), util.ErrorReasonCode(err))
 			}
 
+			if testcase.expectedTags != nil {
+				volumeTemplate, _ := volumeService.CreateVolumeArgsForCall(0)
+				assert.Equal(t, testcase.expectedTags, volumeTemplate.Tags)
+			}
 			if testcase.verify != nil {
 				testcase.verify(t, volume, err)
 			}
@@ -24,6 +24,7 @@ import (
 	"github.com/IBM/ibmcloud-volume-interface/lib/provider"
 	util "github.com/IBM/ibmcloud-volume-interface/lib/utils"
 	"github.com/IBM/ibmcloud-volume-interface/lib/utils/reasoncode"
+	vpcconfig "github.com/IBM/ibmcloud-volume-vpc/block/vpcconfig"
 	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
 	volumeServiceFakes "github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/vpcvolume/fakes"
 	"github.com/stretchr/testify/assert"
@@ -43,6 +44,9 @@ func TestCreateVolume(t *testing.T) {
 	testCases := []struct {
 		testCaseName   string
 		baseVolume     *models.Volume
+		existingVolume *models.Volume
+		policy         string
+		waitErr        error
 		providerVolume provider.Volume
 		profileName    string
 
@@ -275,6 +279,207 @@ func TestCreateVolume(t *testing.T) {
 				assert.Nil(t, volumeResponse)
 				assert.NotNil(t, err)
 			},
+		}, {
+			testCaseName: "Volume already exists with the same parameters",
+			existingVolume: &models.Volume{
+				ID:            "16f293bf-test-4bff-816f-e199c0c65db5",
+				Name:          "test-volume-name",
+				Status:        models.StatusType("available"),
+				Capacity:      int64(10),
+				Profile:       &models.Profile{Name: "general-purpose"},
+				ResourceGroup: &models.ResourceGroup{ID: "default resource group id"},
+				Zone:          &models.Zone{Name: "test-zone"},
+			},
+			baseVolume: &models.Volume{
+				ID:       "16f293bf-test-4bff-816f-e199c0c65db5",
+				Name:     "test-volume-name",
+				Status:   models.StatusType("available"),
+				Capacity: int64(10),
+				Zone:     &models.Zone{Name: "test-zone"},
+			},
+			providerVolume: provider.Volume{
+				Name:     String("test-volume-name"),
+				Capacity: Int(10),
+				Az:       "test-zone",
+				VPCVolume: provider.VPCVolume{
+					Profile:       &provider.Profile{Name: "general-purpose"},
+					ResourceGroup: &provider.ResourceGroup{ID: "default resource group id"},
+				},
+			},
+			verify: func(t *testing.T, volumeResponse *provider.Volume, err error) {
+				assert.Nil(t, err)
+				assert.NotNil(t, volumeResponse)
+				assert.Equal(t, "16f293bf-test-4bff-816f-e199c0c65db5", volumeResponse.VolumeID)
+				assert.Equal(t, 0, volumeService.CreateVolumeCallCount())
+			},
+		}, {
+			testCaseName: "Volume already exists with different parameters",
+			existingVolume: &models.Volume{
+				ID:            "16f293bf-test-4bff-816f-e199c0c65db5",
+				Name:          "test-volume-name",
+				Status:        models.StatusType("available"),
+				Capacity:      int64(20),
+				Profile:       &models.Profile{Name: "general-purpose"},
+				ResourceGroup: &models.ResourceGroup{ID: "default resource group id"},
+				Zone:          &models.Zone{Name: "test-zone"},
+			},
+			providerVolume: provider.Volume{
+				Name:     String("test-volume-name"),
+				Capacity: Int(10),
+				Az:       "test-zone",
+				VPCVolume: provider.VPCVolume{
+					Profile:       &provider.Profile{Name: "general-purpose"},
+					ResourceGroup: &provider.ResourceGroup{ID: "default resource group id"},
+				},
+			},
+			verify: func(t *testing.T, volumeResponse *provider.Volume, err error) {
+				assert.Nil(t, volumeResponse)
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), "VolumeNameConflict")
+				}
+				assert.Equal(t, 0, volumeService.CreateVolumeCallCount())
+			},
+		}, {
+			testCaseName: "Volume ordered but not available is deleted",
+			policy:       vpcconfig.OrphanedVolumeDelete,
+			waitErr:      &models.Error{Errors: []models.ErrorItem{{Code: "volume_id_not_found", Message: "volume not found"}}},
+			baseVolume: &models.Volume{
+				ID:       "16f293bf-test-4bff-816f-e199c0c65db5",
+				Name:     "test-volume-name",
+				Status:   models.StatusType("pending"),
+				Capacity: int64(10),
+				Zone:     &models.Zone{Name: "test-zone"},
+			},
+			providerVolume: provider.Volume{
+				Name:     String("test-volume-name"),
+				Capacity: Int(10),
+				Az:       "test-zone",
+				VPCVolume: provider.VPCVolume{
+					Profile:       &provider.Profile{Name: "general-purpose"},
+					ResourceGroup: &provider.ResourceGroup{ID: "default resource group id"},
+				},
+			},
+			verify: func(t *testing.T, volumeResponse *provider.Volume, err error) {
+				assert.Nil(t, volumeResponse)
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), "Cleanup action taken: volume deleted")
+				}
+				assert.Equal(t, 1, volumeService.CreateVolumeCallCount())
+				assert.Equal(t, 1, volumeService.DeleteVolumeCallCount())
+			},
+		}, {
+			testCaseName: "Existing volume not available is not cleaned up",
+			policy:       vpcconfig.OrphanedVolumeDelete,
+			waitErr:      &models.Error{Errors: []models.ErrorItem{{Code: "volume_id_not_found", Message: "volume not found"}}},
+			existingVolume: &models.Volume{
+				ID:            "16f293bf-test-4bff-816f-e199c0c65db5",
+				Name:          "test-volume-name",
+				Status:        models.StatusType("pending"),
+				Capacity:      int64(10),
+				Profile:       &models.Profile{Name: "general-purpose"},
+				ResourceGroup: &models.ResourceGroup{ID: "default resource group id"},
+				Zone:          &models.Zone{Name: "test-zone"},
+			},
+			providerVolume: provider.Volume{
+				Name:     String("test-volume-name"),
+				Capacity: Int(10),
+				Az:       "test-zone",
+				VPCVolume: provider.VPCVolume{
+					Profile:       &provider.Profile{Name: "general-purpose"},
+					ResourceGroup: &provider.ResourceGroup{ID: "default resource group id"},
+				},
+			},
+			verify: func(t *testing.T, volumeResponse *provider.Volume, err error) {
+				assert.Nil(t, volumeResponse)
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), "VolumeNotInValidState,")
+				}
+				assert.Equal(t, 0, volumeService.CreateVolumeCallCount())
+				assert.Equal(t, 0, volumeService.DeleteVolumeCallCount())
+			},
+		}, {
+			testCaseName: "Volume already exists without resource group",
+			existingVolume: &models.Volume{
+				ID:       "16f293bf-test-4bff-816f-e199c0c65db5",
+				Name:     "test-volume-name",
+				Status:   models.StatusType("available"),
+				Capacity: int64(10),
+				Zone:     &models.Zone{Name: "test-zone"},
+				Profile:  &models.Profile{Name: "general-purpose"},
+			},
+			providerVolume: provider.Volume{
+				Name:     String("test-volume-name"),
+				Capacity: Int(10),
+				Az:       "test-zone",
+				VPCVolume: provider.VPCVolume{
+					Profile:       &provider.Profile{Name: "general-purpose"},
+					ResourceGroup: &provider.ResourceGroup{ID: "default resource group id"},
+				},
+			},
+			verify: func(t *testing.T, volumeResponse *provider.Volume, err error) {
+				assert.Nil(t, volumeResponse)
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), "VolumeNameConflict")
+				}
+				assert.Equal(t, 0, volumeService.CreateVolumeCallCount())
+			},
+		}, {
+			testCaseName: "Volume already exists with different IOPS",
+			existingVolume: &models.Volume{
+				ID:            "16f293bf-test-4bff-816f-e199c0c65db5",
+				Name:          "test-volume-name",
+				Status:        models.StatusType("available"),
+				Capacity:      int64(10),
+				Zone:          &models.Zone{Name: "test-zone"},
+				Iops:          int64(1000),
+				Profile:       &models.Profile{Name: "custom"},
+				ResourceGroup: &models.ResourceGroup{ID: "default resource group id"},
+			},
+			providerVolume: provider.Volume{
+				Name:     String("test-volume-name"),
+				Capacity: Int(10),
+				Az:       "test-zone",
+				Iops:     String("2000"),
+				VPCVolume: provider.VPCVolume{
+					Profile:       &provider.Profile{Name: "custom"},
+					ResourceGroup: &provider.ResourceGroup{ID: "default resource group id"},
+				},
+			},
+			verify: func(t *testing.T, volumeResponse *provider.Volume, err error) {
+				assert.Nil(t, volumeResponse)
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), "VolumeNameConflict")
+				}
+				assert.Equal(t, 0, volumeService.CreateVolumeCallCount())
+			},
+		}, {
+			testCaseName: "Volume already exists without the requested encryption key",
+			existingVolume: &models.Volume{
+				ID:            "16f293bf-test-4bff-816f-e199c0c65db5",
+				Name:          "test-volume-name",
+				Status:        models.StatusType("available"),
+				Capacity:      int64(10),
+				Zone:          &models.Zone{Name: "test-zone"},
+				Profile:       &models.Profile{Name: "general-purpose"},
+				ResourceGroup: &models.ResourceGroup{ID: "default resource group id"},
+			},
+			providerVolume: provider.Volume{
+				Name:     String("test-volume-name"),
+				Capacity: Int(10),
+				Az:       "test-zone",
+				VPCVolume: provider.VPCVolume{
+					Profile:             &provider.Profile{Name: "general-purpose"},
+					ResourceGroup:       &provider.ResourceGroup{ID: "default resource group id"},
+					VolumeEncryptionKey: &provider.VolumeEncryptionKey{CRN: "crn:v1:bluemix:public:kms:us-south:a/test-account:key:test-key"},
+				},
+			},
+			verify: func(t *testing.T, volumeResponse *provider.Volume, err error) {
+				assert.Nil(t, volumeResponse)
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), "VolumeNameConflict")
+				}
+				assert.Equal(t, 0, volumeService.CreateVolumeCallCount())
+			},
 		},
 	}
 
@@ -289,6 +494,7 @@ func TestCreateVolume(t *testing.T) {
 			volumeService = &volumeServiceFakes.VolumeService{}
 			assert.NotNil(t, volumeService)
 			uc.VolumeServiceReturns(volumeService)
+			volumeService.GetVolumeByNameReturns(testcase.existingVolume, nil)
 
 			if testcase.expectedErr != "" {
 				volumeService.CreateVolumeReturns(testcase.baseVolume, errors.New(testcase.expectedReasonCode))
@@ -297,6 +503,12 @@ func TestCreateVolume(t *testing.T) {
 				volumeService.CreateVolumeReturns(testcase.baseVolume, nil)
 				volumeService.GetVolumeReturns(testcase.baseVolume, nil)
 			}
+			if testcase.waitErr != nil {
+				vpcs.Config.OrphanedVolumePolicy = testcase.policy
+				volumeService.GetVolumeReturns(nil, testcase.waitErr)
+				// Volume is found by the checks before deletion
+				volumeService.GetVolumeReturnsOnCall(1, testcase.baseVolume, nil)
+			}
 			volume, err := vpcs.CreateVolume(testcase.providerVolume)
 			logger.Info("Volume details", zap.Reflect("volume", volume))
 
@@ -19,6 +19,7 @@ package provider
 
 import (
 	"github.com/IBM/ibmcloud-volume-interface/lib/provider"
+	"github.com/IBM/ibmcloud-volume-interface/lib/utils/reasoncode"
 	userError "github.com/IBM/ibmcloud-volume-vpc/common/messages"
 	"go.uber.org/zap"
 )
@@ -28,14 +29,28 @@ func (vpcs *VPCSession) DeleteSnapshot(snapshot *provider.Snapshot) error {
 	vpcs.Logger.Info("Entry DeleteSnapshot", zap.Reflect("snapshot", snapshot))
 	defer vpcs.Logger.Info("Exit DeleteSnapshot", zap.Reflect("snapshot", snapshot))
 
-	var err error
-	_, err = vpcs.GetSnapshot(snapshot.SnapshotID)
+	if snapshot == nil {
+		return userError.GetUserError(string(reasoncode.ErrorRequiredFieldMissing), nil, "Snapshot")
+	}
+
+	existingSnapshot, err := vpcs.GetSnapshot(snapshot.SnapshotID)
 	if err != nil {
-		return userError.GetUserError("StorageFindFailedWithSnapshotId", err, snapshot.SnapshotID, "Not a valid snapshot ID")
+		return err
+	}
+
+	// Snapshot may be passed with only its ID, fall back to the source volume reported by the backend
+	volumeID := snapshot.Volume.VolumeID
+	if volumeID == "" {
+		volumeID = existingSnapshot.VolumeID
 	}
 
-	err = retry(vpcs.Logger, func() error {
-		err = vpcs.Apiclient.SnapshotService().DeleteSnapshot(snapshot.Volume.VolumeID, snapshot.SnapshotID, vpcs.Logger)
+	err = vpcs.APIRetry.Retry(vpcs.GetContext(), vpcs.Logger, func() error {
+		// Snapshots without a source volume are deleted through the top level snapshots API
+		if volumeID == "" {
+			err = vpcs.Apiclient.WithContext(vpcs.GetContext()).SnapshotService().DeleteSnapshotByID(snapshot.SnapshotID, vpcs.Logger)
+		} else {
+			err = vpcs.Apiclient.WithContext(vpcs.GetContext()).SnapshotService().DeleteSnapshot(volumeID, snapshot.SnapshotID, vpcs.Logger)
+		}
 		return err
 	})
 
@@ -44,6 +44,7 @@ func TestDeleteSnapshot(t *testing.T) {
 		testCaseName     string
 		baseVolume       *models.Volume
 		baseSnapshot     *models.Snapshot
+		snapshotErr      error
 		providerVolume   *provider.Volume
 		providerSnapshot *provider.Snapshot
 
@@ -57,7 +58,11 @@ func TestDeleteSnapshot(t *testing.T) {
 		verify func(t *testing.T, err error)
 	}{
 		{
-			testCaseName: "Not supported yet",
+			testCaseName: "Snapshot deleted",
+			baseSnapshot: &models.Snapshot{
+				ID:           "s6f293bf-test-4bff-816f-e199c0c65db5",
+				SourceVolume: &models.Volume{ID: "16f293bf-test-4bff-816f-e199c0c65db5"},
+			},
 			providerSnapshot: &provider.Snapshot{
 				SnapshotID: "s6f293bf-test-4bff-816f-e199c0c65db5",
 				Volume: provider.Volume{
@@ -73,6 +78,34 @@ func TestDeleteSnapshot(t *testing.T) {
 			verify: func(t *testing.T, err error) {
 				assert.Nil(t, err)
 			},
+		}, {
+			testCaseName: "Snapshot deleted with only snapshot ID",
+			baseSnapshot: &models.Snapshot{
+				ID:           "s6f293bf-test-4bff-816f-e199c0c65db5",
+				SourceVolume: &models.Volume{ID: "16f293bf-test-4bff-816f-e199c0c65db5"},
+			},
+			providerSnapshot: &provider.Snapshot{
+				SnapshotID: "s6f293bf-test-4bff-816f-e199c0c65db5",
+			},
+			verify: func(t *testing.T, err error) {
+				assert.Nil(t, err)
+				volumeID, _, _ := snapshotService.DeleteSnapshotArgsForCall(0)
+				assert.Equal(t, "16f293bf-test-4bff-816f-e199c0c65db5", volumeID)
+			},
+		}, {
+			testCaseName: "Snapshot deleted without source volume",
+			baseSnapshot: &models.Snapshot{
+				ID: "s6f293bf-test-4bff-816f-e199c0c65db5",
+			},
+			providerSnapshot: &provider.Snapshot{
+				SnapshotID: "s6f293bf-test-4bff-816f-e199c0c65db5",
+			},
+			verify: func(t *testing.T, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, 0, snapshotService.DeleteSnapshotCallCount())
+				snapshotID, _ := snapshotService.DeleteSnapshotByIDArgsForCall(0)
+				assert.Equal(t, "s6f293bf-test-4bff-816f-e199c0c65db5", snapshotID)
+			},
 		}, {
 			testCaseName: "Not a valid snapshot",
 			providerSnapshot: &provider.Snapshot{
@@ -87,7 +120,8 @@ func TestDeleteSnapshot(t *testing.T) {
 					},
 				},
 			},
-			expectedErr:        "{Code:ErrorUnclassified, Type:InvalidRequest, Description:'Not a valid snapshot ID",
+			snapshotErr:        &models.Error{Errors: []models.ErrorItem{{Code: models.ErrorCodeNotFound, Message: "snapshot not found"}}},
+			expectedErr:        "{Code:SnapshotIDNotFound, Type:RetrivalFailed, Description:A snapshot with the specified snapshot ID 's6f293bf-test-4bff-816f-e199c0c65db5' could not be found.",
 			expectedReasonCode: "ErrorUnclassified",
 			verify: func(t *testing.T, err error) {
 				assert.NotNil(t, err)
@@ -113,10 +147,15 @@ func TestDeleteSnapshot(t *testing.T) {
 
 			if testcase.expectedErr != "" {
 				snapshotService.DeleteSnapshotReturns(errors.New(testcase.expectedReasonCode))
-				snapshotService.GetSnapshotReturns(testcase.baseSnapshot, errors.New(testcase.expectedReasonCode))
+				snapshotErr := testcase.snapshotErr
+				if snapshotErr == nil {
+					snapshotErr = errors.New(testcase.expectedReasonCode)
+				}
+				snapshotService.GetSnapshotByIDReturns(testcase.baseSnapshot, snapshotErr)
 			} else {
 				snapshotService.DeleteSnapshotReturns(nil)
-				snapshotService.GetSnapshotReturns(testcase.baseSnapshot, nil)
+				snapshotService.DeleteSnapshotByIDReturns(nil)
+				snapshotService.GetSnapshotByIDReturns(testcase.baseSnapshot, nil)
 			}
 			err = vpcs.DeleteSnapshot(testcase.providerSnapshot)
 
@@ -18,6 +18,7 @@
 package provider
 
 import (
+	"strings"
 	"time"
 
 	"github.com/IBM/ibmcloud-volume-interface/lib/metrics"
@@ -27,21 +28,52 @@ import (
 	"go.uber.org/zap"
 )
 
-// DeleteVolume deletes the volume
+// DeleteVolume deletes the volume, it fails if the volume is still attached to any instance
 func (vpcs *VPCSession) DeleteVolume(volume *provider.Volume) (err error) {
+	return vpcs.deleteVolume(volume, false)
+}
+
+// ForceDeleteVolume detaches the volume from all the instances it is attached to and deletes it
+func (vpcs *VPCSession) ForceDeleteVolume(volume *provider.Volume) (err error) {
+	return vpcs.deleteVolume(volume, true)
+}
+
+func (vpcs *VPCSession) deleteVolume(volume *provider.Volume, forceDetach bool) (err error) {
 	vpcs.Logger.Debug("Entry of DeleteVolume method...")
 	defer vpcs.Logger.Debug("Exit from DeleteVolume method...")
 	defer metrics.UpdateDurationFromStart(vpcs.Logger, "DeleteVolume", time.Now())
 
-	vpcs.Logger.Info("Validating basic inputs for DeleteVolume method...", zap.Reflect("VolumeDetails", volume))
+	vpcs.Logger.Info("Validating basic inputs for DeleteVolume method...", zap.Reflect("VolumeDetails", volume), zap.Bool("ForceDetach", forceDetach))
 	err = validateVolume(volume)
 	if err != nil {
 		return err
 	}
 
+	vpcs.Logger.Info("Checking volume attachments before deletion...", zap.Reflect("VolumeID", volume.VolumeID))
+	var existingVolume *models.Volume
+	err = vpcs.APIRetry.Retry(vpcs.GetContext(), vpcs.Logger, func() error {
+		existingVolume, err = vpcs.Apiclient.WithContext(vpcs.GetContext()).VolumeService().GetVolume(volume.VolumeID, vpcs.Logger)
+		return err
+	})
+	if err != nil {
+		return userError.GetUserError("StorageFindFailedWithVolumeId", err, volume.VolumeID)
+	}
+
+	if existingVolume != nil && existingVolume.VolumeAttachments != nil && len(*existingVolume.VolumeAttachments) > 0 {
+		if !forceDetach {
+			instanceIDs := getAttachedInstanceIDs(existingVolume)
+			vpcs.Logger.Info("Volume is attached to instances", zap.Reflect("VolumeID", volume.VolumeID), zap.Reflect("InstanceIDs", instanceIDs))
+			return userError.GetUserError("VolumeInUse", nil, volume.VolumeID, strings.Join(instanceIDs, ", "))
+		}
+		err = vpcs.detachAllVolumeAttachments(existingVolume)
+		if err != nil {
+			return err
+		}
+	}
+
 	vpcs.Logger.Info("Deleting volume from VPC provider...")
-	err = retry(vpcs.Logger, func() error {
-		err = vpcs.Apiclient.VolumeService().DeleteVolume(volume.VolumeID, vpcs.Logger)
+	err = vpcs.APIRetry.Retry(vpcs.GetContext(), vpcs.Logger, func() error {
+		err = vpcs.Apiclient.WithContext(vpcs.GetContext()).VolumeService().DeleteVolume(volume.VolumeID, vpcs.Logger)
 		return err
 	})
 	if err != nil {
@@ -57,6 +89,50 @@ func (vpcs *VPCSession) DeleteVolume(volume *provider.Volume) (err error) {
 	return err
 }
 
+// detachAllVolumeAttachments detaches the volume from every instance it is attached to and waits for the detach to complete
+func (vpcs *VPCSession) detachAllVolumeAttachments(volume *models.Volume) error {
+	for _, volumeAttachment := range *volume.VolumeAttachments {
+		if volumeAttachment.Instance == nil || len(volumeAttachment.Instance.ID) == 0 {
+			// Without the instance the attachment can not be detached, leave it to the user
+			return userError.GetUserError("VolumeInUse", nil, volume.ID, strings.Join(getAttachedInstanceIDs(volume), ", "))
+		}
+
+		volumeAttachmentRequest := provider.VolumeAttachmentRequest{
+			VolumeID:   volume.ID,
+			InstanceID: volumeAttachment.Instance.ID,
+			VPCVolumeAttachment: &provider.VolumeAttachment{
+				ID: volumeAttachment.ID,
+			},
+		}
+
+		vpcs.Logger.Info("Force detaching volume before deletion...", zap.Reflect("VolumeAttachmentRequest", volumeAttachmentRequest))
+		_, err := vpcs.DetachVolume(volumeAttachmentRequest)
+		if err != nil {
+			return err
+		}
+
+		err = vpcs.WaitForDetachVolume(volumeAttachmentRequest)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getAttachedInstanceIDs returns the IDs of the instances the volume is attached to
+func getAttachedInstanceIDs(volume *models.Volume) []string {
+	instanceIDs := []string{}
+	for _, volumeAttachment := range *volume.VolumeAttachments {
+		if volumeAttachment.Instance != nil && len(volumeAttachment.Instance.ID) > 0 {
+			instanceIDs = append(instanceIDs, volumeAttachment.Instance.ID)
+		} else {
+			// Instance reference is missing, report the attachment instead
+			instanceIDs = append(instanceIDs, "attachment "+volumeAttachment.ID)
+		}
+	}
+	return instanceIDs
+}
+
 // validateVolume validating volume ID
 func validateVolume(volume *provider.Volume) (err error) {
 	if volume == nil {
@@ -79,11 +155,16 @@ func WaitForVolumeDeletion(vpcs *VPCSession, volumeID string) (err error) {
 
 	vpcs.Logger.Info("Getting volume details from VPC provider...", zap.Reflect("VolumeID", volumeID))
 
-	err = vpcs.APIRetry.FlexyRetry(vpcs.Logger, func() (error, bool) {
-		_, err = vpcs.Apiclient.VolumeService().GetVolume(volumeID, vpcs.Logger)
+	err = vpcs.APIRetry.FlexyRetry(vpcs.GetContext(), vpcs.Logger, func() (error, bool) {
+		_, err = vpcs.Apiclient.WithContext(vpcs.GetContext()).VolumeService().GetVolume(volumeID, vpcs.Logger)
 		// Keep retry, until GetVolume returns volume not found
 		if err != nil {
-			skip = skipRetry(err.(*models.Error))
+			modelErr, ok := err.(*models.Error)
+			if !ok {
+				// Not a backend error, e.g. the request was cancelled as the context is done, stop with it
+				return err, true
+			}
+			skip = skipRetry(modelErr)
 			return nil, skip
 		}
 		return err, false // continue retry as we are not seeing error which means volume is available
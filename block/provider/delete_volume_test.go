@@ -18,12 +18,15 @@
 package provider
 
 import (
+	"context"
 	"errors"
+	"net/http"
 	"testing"
 
 	"github.com/IBM/ibmcloud-volume-interface/lib/provider"
 	util "github.com/IBM/ibmcloud-volume-interface/lib/utils"
 	"github.com/IBM/ibmcloud-volume-interface/lib/utils/reasoncode"
+	volumeAttachServiceFakes "github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/instances/fakes"
 	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
 	serviceFakes "github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/vpcvolume/fakes"
 	"github.com/stretchr/testify/assert"
@@ -205,3 +208,157 @@ func TestDeleteVolumeTwo(t *testing.T) {
 	err = vpcs.DeleteVolume(providerVolume)
 	assert.NotNil(t, err)
 }
+
+func TestDeleteVolumeWithAttachments(t *testing.T) {
+	//var err error
+	logger, teardown := GetTestLogger(t)
+	defer teardown()
+
+	var (
+		volumeService       *serviceFakes.VolumeService
+		volumeAttachService *volumeAttachServiceFakes.VolumeAttachService
+	)
+
+	notFoundErr := &models.Error{Errors: []models.ErrorItem{{Code: "not_found", Message: "not found"}}}
+
+	attachedVolume := &models.Volume{
+		ID:     "16f293bf-test-4bff-816f-e199c0c65db5",
+		Name:   "test volume name",
+		Status: models.StatusType("available"),
+		Zone:   &models.Zone{Name: "test-zone"},
+		VolumeAttachments: &[]models.VolumeAttachment{
+			{ID: "attachment-id1", Instance: &models.Instance{ID: "instance-id1"}},
+			{ID: "attachment-id2", Instance: &models.Instance{ID: "instance-id2"}},
+		},
+	}
+
+	testCases := []struct {
+		testCaseName string
+		forceDetach  bool
+		detachErr    error
+
+		verify func(t *testing.T, err error)
+	}{
+		{
+			testCaseName: "Attached volume is not deleted",
+			verify: func(t *testing.T, err error) {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), "VolumeInUse")
+					assert.Contains(t, err.Error(), "instance-id1, instance-id2")
+				}
+				assert.Equal(t, 0, volumeAttachService.DetachVolumeCallCount())
+				assert.Equal(t, 0, volumeService.DeleteVolumeCallCount())
+			},
+		}, {
+			testCaseName: "Attached volume is detached and deleted with force",
+			forceDetach:  true,
+			verify: func(t *testing.T, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, 2, volumeAttachService.DetachVolumeCallCount())
+				volumeAttachment, _ := volumeAttachService.DetachVolumeArgsForCall(1)
+				assert.Equal(t, "attachment-id2", volumeAttachment.ID)
+				assert.Equal(t, "instance-id2", *volumeAttachment.InstanceID)
+				assert.Equal(t, 1, volumeService.DeleteVolumeCallCount())
+			},
+		}, {
+			testCaseName: "Force detach failed",
+			forceDetach:  true,
+			detachErr:    notFoundErr,
+			verify: func(t *testing.T, err error) {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), "VolumeDetachFailed")
+				}
+				assert.Equal(t, 0, volumeService.DeleteVolumeCallCount())
+			},
+		},
+	}
+
+	for _, testcase := range testCases {
+		t.Run(testcase.testCaseName, func(t *testing.T) {
+			vpcs, uc, sc, err := GetTestOpenSession(t, logger)
+			assert.NotNil(t, vpcs)
+			assert.NotNil(t, uc)
+			assert.NotNil(t, sc)
+			assert.Nil(t, err)
+
+			volumeService = &serviceFakes.VolumeService{}
+			assert.NotNil(t, volumeService)
+			uc.VolumeServiceReturns(volumeService)
+
+			volumeAttachService = &volumeAttachServiceFakes.VolumeAttachService{}
+			assert.NotNil(t, volumeAttachService)
+			volumeAttachService.WithContextReturns(volumeAttachService)
+			vpcs.APIClientVolAttachMgr = volumeAttachService
+
+			// Volume is reported as gone once deleted
+			volumeService.GetVolumeReturnsOnCall(0, attachedVolume, nil)
+			volumeService.GetVolumeReturns(nil, notFoundErr)
+			volumeService.DeleteVolumeReturns(nil)
+
+			// Each attachment is found before the detach, and reported as gone by the detach and wait checks
+			volumeAttachService.GetVolumeAttachmentReturnsOnCall(0, &models.VolumeAttachment{ID: "attachment-id1", Status: "attached", Volume: &models.Volume{ID: attachedVolume.ID}}, nil)
+			volumeAttachService.GetVolumeAttachmentReturnsOnCall(1, nil, notFoundErr)
+			volumeAttachService.GetVolumeAttachmentReturnsOnCall(2, nil, notFoundErr)
+			volumeAttachService.GetVolumeAttachmentReturnsOnCall(3, &models.VolumeAttachment{ID: "attachment-id2", Status: "attached", Volume: &models.Volume{ID: attachedVolume.ID}}, nil)
+			volumeAttachService.GetVolumeAttachmentReturnsOnCall(4, nil, notFoundErr)
+			volumeAttachService.GetVolumeAttachmentReturnsOnCall(5, nil, notFoundErr)
+			volumeAttachService.DetachVolumeReturns(&http.Response{StatusCode: http.StatusOK}, testcase.detachErr)
+
+			if testcase.forceDetach {
+				err = vpcs.ForceDeleteVolume(&provider.Volume{VolumeID: attachedVolume.ID})
+			} else {
+				err = vpcs.DeleteVolume(&provider.Volume{VolumeID: attachedVolume.ID})
+			}
+			logger.Info("Error details", zap.Reflect("Error details", err))
+
+			if testcase.verify != nil {
+				testcase.verify(t, err)
+			}
+		})
+	}
+}
+
+func TestWaitForVolumeDeletion(t *testing.T) {
+	logger, teardown := GetTestLogger(t)
+	defer teardown()
+
+	testCases := []struct {
+		testCaseName string
+		getErr       error
+
+		expectedErr       string
+		expectedGetVolume int
+	}{
+		{
+			testCaseName:      "Volume is gone",
+			getErr:            &models.Error{Errors: []models.ErrorItem{{Code: models.ErrorCodeNotFound, Message: "volume not found"}}, StatusCode: 404},
+			expectedGetVolume: 1,
+		}, {
+			testCaseName:      "Volume can not be retrieved",
+			getErr:            context.Canceled,
+			expectedErr:       "context canceled",
+			expectedGetVolume: 1,
+		},
+	}
+
+	for _, testcase := range testCases {
+		t.Run(testcase.testCaseName, func(t *testing.T) {
+			vpcs, uc, _, err := GetTestOpenSession(t, logger)
+			assert.Nil(t, err)
+
+			volumeService := &serviceFakes.VolumeService{}
+			uc.VolumeServiceReturns(volumeService)
+			volumeService.GetVolumeReturns(nil, testcase.getErr)
+
+			err = WaitForVolumeDeletion(vpcs, "16f293bf-test-4bff-816f-e199c0c65db5")
+			if testcase.expectedErr != "" {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), testcase.expectedErr)
+				}
+			} else {
+				assert.Nil(t, err)
+			}
+			assert.Equal(t, testcase.expectedGetVolume, volumeService.GetVolumeCallCount())
+		})
+	}
+}
@@ -44,7 +44,7 @@ func (vpcs *VPCSession) DetachVolume(volumeAttachmentTemplate provider.VolumeAtt
 	var response *http.Response
 	var volumeAttachment models.VolumeAttachment
 
-	err = vpcs.APIRetry.FlexyRetry(vpcs.Logger, func() (error, bool) {
+	err = vpcs.APIRetry.FlexyRetry(vpcs.GetContext(), vpcs.Logger, func() (error, bool) {
 		// First , check if volume is already attached to given instance
 		vpcs.Logger.Info("Checking if volume is already attached ")
 		currentVolAttachment, err := vpcs.GetVolumeAttachment(volumeAttachmentTemplate)
@@ -54,8 +54,8 @@ func (vpcs *VPCSession) DetachVolume(volumeAttachmentTemplate provider.VolumeAtt
 			volumeAttachment := models.NewVolumeAttachment(volumeAttachmentTemplate)
 			volumeAttachment.ID = currentVolAttachment.VPCVolumeAttachment.ID
 			vpcs.Logger.Info("Detaching volume from VPC provider...")
-			response, err = vpcs.APIClientVolAttachMgr.DetachVolume(&volumeAttachment, vpcs.Logger) //nolint:bodyclose
-			return err, skipRetryForObviousErrors(err, vpcs.Config.VPCConfig.IsIKS)                 // Retry in case of all errors
+			response, err = vpcs.APIClientVolAttachMgr.WithContext(vpcs.GetContext()).DetachVolume(&volumeAttachment, vpcs.Logger) //nolint:bodyclose
+			return err, skipRetryForObviousErrors(err, vpcs.Config.VPCConfig.IsIKS)                                                // Retry in case of all errors
 		}
 		vpcs.Logger.Info("No volume attachment found for", zap.Reflect("currentVolAttachment", currentVolAttachment), zap.Error(err))
 		// consider volume detach success if its  already  in Detaching or VolumeAttachment is not found
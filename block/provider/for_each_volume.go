@@ -0,0 +1,65 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package provider ...
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/IBM/ibmcloud-volume-interface/lib/metrics"
+	"github.com/IBM/ibmcloud-volume-interface/lib/provider"
+	userError "github.com/IBM/ibmcloud-volume-vpc/common/messages"
+	"go.uber.org/zap"
+)
+
+// ForEachVolume calls fn for every volume matching the filters, following all the pages returned by the backend.
+// Filters are the same as for ListVolumes. The walk stops at the first error returned by fn, which is returned as is,
+// when ctx is cancelled, including while a page is fetched or retried, or when a page can not be fetched, e.g. StartVolumeIDNotFound if the page token expired.
+func (vpcs *VPCSession) ForEachVolume(ctx context.Context, filters map[string]string, fn func(volume *provider.Volume) error) error {
+	vpcs.Logger.Info("Entry ForEachVolume", zap.Reflect("filters", filters))
+	defer vpcs.Logger.Info("Exit ForEachVolume", zap.Reflect("filters", filters))
+	defer metrics.UpdateDurationFromStart(vpcs.Logger, "ForEachVolume", time.Now())
+
+	start := ""
+	for {
+		if err := ctx.Err(); err != nil {
+			vpcs.Logger.Info("Volume walk cancelled", zap.Reflect("start", start), zap.Error(err))
+			return userError.GetUserError("ListVolumesFailed", err)
+		}
+
+		volumes, err := vpcs.listVolumes(ctx, maxLimit, start, filters)
+		if err != nil {
+			return err
+		}
+
+		for _, volume := range volumes.Volumes {
+			if err = ctx.Err(); err != nil {
+				vpcs.Logger.Info("Volume walk cancelled", zap.Reflect("start", start), zap.Error(err))
+				return userError.GetUserError("ListVolumesFailed", err)
+			}
+			if err = fn(volume); err != nil {
+				return err
+			}
+		}
+
+		if volumes.Next == "" {
+			return nil
+		}
+		start = volumes.Next
+	}
+}
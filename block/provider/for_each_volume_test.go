@@ -0,0 +1,184 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package provider ...
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/IBM/ibmcloud-volume-interface/lib/provider"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
+	volumeServiceFakes "github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/vpcvolume/fakes"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestForEachVolume(t *testing.T) {
+	//var err error
+	logger, teardown := GetTestLogger(t)
+	defer teardown()
+
+	var (
+		volumeService *volumeServiceFakes.VolumeService
+		cancelWalk    context.CancelFunc
+	)
+
+	firstPage := &models.VolumeList{
+		Next: &models.HReference{Href: "https://eu-gb.iaas.cloud.ibm.com/v1/volumes?limit=100&start=23b154fr-test-4bff-816f-f213s1y34gj8&zone.name=test-zone-1"},
+		Volumes: []*models.Volume{
+			{ID: "16f293bf-test-4bff-816f-e199c0c65db5", Capacity: int64(10), Zone: &models.Zone{Name: "test-zone-1"}},
+		},
+	}
+	lastPage := &models.VolumeList{
+		Volumes: []*models.Volume{
+			{ID: "23b154fr-test-4bff-816f-f213s1y34gj8", Capacity: int64(10), Zone: &models.Zone{Name: "test-zone-1"}},
+			{ID: "33c154fr-test-4bff-816f-f213s1y34gj9", Capacity: int64(20), Zone: &models.Zone{Name: "test-zone-1"}},
+		},
+	}
+	expiredStartErr := &models.Error{Errors: []models.ErrorItem{{Code: models.ErrorCodeNotFound, Message: startVolumeIDNotFoundMsg}}}
+
+	testCases := []struct {
+		testCaseName string
+		filters      map[string]string
+		setup        func()
+		cancelAfter  int
+		fnErr        error
+
+		expectedVolumeIDs []string
+		expectedErr       string
+		expectedStarts    []string
+	}{
+		{
+			testCaseName: "Walk all pages",
+			setup: func() {
+				volumeService.ListVolumesReturnsOnCall(0, firstPage, nil)
+				volumeService.ListVolumesReturnsOnCall(1, lastPage, nil)
+			},
+			expectedVolumeIDs: []string{"16f293bf-test-4bff-816f-e199c0c65db5", "23b154fr-test-4bff-816f-f213s1y34gj8", "33c154fr-test-4bff-816f-f213s1y34gj9"},
+			expectedStarts:    []string{"", "23b154fr-test-4bff-816f-f213s1y34gj8"},
+		}, {
+			testCaseName: "Page token expired during the walk",
+			setup: func() {
+				volumeService.ListVolumesReturnsOnCall(0, firstPage, nil)
+				volumeService.ListVolumesReturnsOnCall(1, nil, expiredStartErr)
+			},
+			expectedVolumeIDs: []string{"16f293bf-test-4bff-816f-e199c0c65db5"},
+			expectedErr:       "StartVolumeIDNotFound",
+			expectedStarts:    []string{"", "23b154fr-test-4bff-816f-f213s1y34gj8"},
+		}, {
+			testCaseName: "Callback returns error",
+			setup: func() {
+				volumeService.ListVolumesReturnsOnCall(0, firstPage, nil)
+				volumeService.ListVolumesReturnsOnCall(1, lastPage, nil)
+			},
+			fnErr:             errors.New("stop walking"),
+			expectedVolumeIDs: []string{"16f293bf-test-4bff-816f-e199c0c65db5"},
+			expectedErr:       "stop walking",
+			expectedStarts:    []string{""},
+		}, {
+			testCaseName: "Context cancelled during the walk",
+			setup: func() {
+				volumeService.ListVolumesReturnsOnCall(0, firstPage, nil)
+				volumeService.ListVolumesReturnsOnCall(1, lastPage, nil)
+			},
+			cancelAfter:       2,
+			expectedVolumeIDs: []string{"16f293bf-test-4bff-816f-e199c0c65db5", "23b154fr-test-4bff-816f-f213s1y34gj8"},
+			expectedErr:       "context canceled",
+			expectedStarts:    []string{"", "23b154fr-test-4bff-816f-f213s1y34gj8"},
+		}, {
+			testCaseName: "Context cancelled while a page is retried",
+			setup: func() {
+				volumeService.ListVolumesStub = func(limit int, start string, filters *models.ListVolumeFilters, ctxLogger *zap.Logger) (*models.VolumeList, error) {
+					if volumeService.ListVolumesCallCount() == 1 {
+						return firstPage, nil
+					}
+					cancelWalk()
+					return nil, &models.Error{Errors: []models.ErrorItem{{Code: "internal_error", Message: "try again"}}, StatusCode: 500}
+				}
+			},
+			expectedVolumeIDs: []string{"16f293bf-test-4bff-816f-e199c0c65db5"},
+			expectedErr:       "context canceled",
+			expectedStarts:    []string{"", "23b154fr-test-4bff-816f-f213s1y34gj8"},
+		}, {
+			testCaseName: "Unknown filter",
+			filters:      map[string]string{"size": "10"},
+			setup: func() {
+				volumeService.ListVolumesReturns(lastPage, nil)
+			},
+			expectedErr: "InvalidListFilter",
+		},
+	}
+
+	for _, testcase := range testCases {
+		t.Run(testcase.testCaseName, func(t *testing.T) {
+			vpcs, uc, sc, err := GetTestOpenSession(t, logger)
+			assert.NotNil(t, vpcs)
+			assert.NotNil(t, uc)
+			assert.NotNil(t, sc)
+			assert.Nil(t, err)
+
+			volumeService = &volumeServiceFakes.VolumeService{}
+			assert.NotNil(t, volumeService)
+			uc.VolumeServiceReturns(volumeService)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			cancelWalk = cancel
+			testcase.setup()
+
+			filters := testcase.filters
+			if filters == nil {
+				filters = map[string]string{"zone.name": "test-zone-1"}
+			}
+
+			volumeIDs := []string{}
+			err = vpcs.ForEachVolume(ctx, filters, func(volume *provider.Volume) error {
+				volumeIDs = append(volumeIDs, volume.VolumeID)
+				if len(volumeIDs) == testcase.cancelAfter {
+					cancel()
+				}
+				if testcase.fnErr != nil {
+					return testcase.fnErr
+				}
+				return nil
+			})
+
+			if testcase.expectedErr != "" {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), testcase.expectedErr)
+				}
+			} else {
+				assert.Nil(t, err)
+			}
+			if len(testcase.expectedVolumeIDs) > 0 {
+				assert.Equal(t, testcase.expectedVolumeIDs, volumeIDs)
+			}
+
+			starts := []string{}
+			for i := 0; i < volumeService.ListVolumesCallCount(); i++ {
+				limit, start, _, _ := volumeService.ListVolumesArgsForCall(i)
+				assert.Equal(t, maxLimit, limit)
+				starts = append(starts, start)
+			}
+			if len(testcase.expectedStarts) > 0 {
+				assert.Equal(t, testcase.expectedStarts, starts)
+			}
+		})
+	}
+}
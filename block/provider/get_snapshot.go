@@ -19,6 +19,7 @@ package provider
 
 import (
 	"github.com/IBM/ibmcloud-volume-interface/lib/provider"
+	"github.com/IBM/ibmcloud-volume-interface/lib/utils/reasoncode"
 	userError "github.com/IBM/ibmcloud-volume-vpc/common/messages"
 	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
 	"go.uber.org/zap"
@@ -29,38 +30,55 @@ func (vpcs *VPCSession) GetSnapshot(snapshotID string) (*provider.Snapshot, erro
 	vpcs.Logger.Info("Entry GetSnapshot", zap.Reflect("SnapshotID", snapshotID))
 	defer vpcs.Logger.Info("Exit GetSnapshot", zap.Reflect("SnapshotID", snapshotID))
 
-	return nil, nil
-}
-
-// GetSnapshotWithVolumeID get snapshot
-func (vpcs *VPCSession) GetSnapshotWithVolumeID(volumeID string, snapshotID string) (*provider.Snapshot, error) {
-	vpcs.Logger.Info("Entry GetSnapshot", zap.Reflect("SnapshotID", snapshotID))
-	defer vpcs.Logger.Info("Exit GetSnapshot", zap.Reflect("SnapshotID", snapshotID))
+	if snapshotID == "" {
+		return nil, userError.GetUserError(string(reasoncode.ErrorRequiredFieldMissing), nil, "SnapshotID")
+	}
 
 	var err error
 	var snapshot *models.Snapshot
 
-	err = retry(vpcs.Logger, func() error {
-		snapshot, err = vpcs.Apiclient.SnapshotService().GetSnapshot(volumeID, snapshotID, vpcs.Logger)
+	err = vpcs.APIRetry.Retry(vpcs.GetContext(), vpcs.Logger, func() error {
+		snapshot, err = vpcs.Apiclient.WithContext(vpcs.GetContext()).SnapshotService().GetSnapshotByID(snapshotID, vpcs.Logger)
 		return err
 	})
 
 	if err != nil {
-		return nil, userError.GetUserError("FailedToDeleteSnapshot", err)
+		// Only a missing snapshot is reported as not found, other failures keep their cause
+		if isNotFoundError(err) {
+			return nil, userError.GetUserError("SnapshotIDNotFound", err, snapshotID)
+		}
+		return nil, userError.GetUserError("RetrieveSnapshotFailed", err, snapshotID)
 	}
 
 	vpcs.Logger.Info("Successfully retrieved the snapshot details", zap.Reflect("Snapshot", snapshot))
 
-	volume, err := vpcs.GetVolume(volumeID)
+	respSnapshot := FromProviderToLibSnapshot(snapshot, vpcs.Logger)
+	vpcs.Logger.Info("Successfully retrieved the snapshot details", zap.Reflect("Provider snapshot", respSnapshot))
+	return respSnapshot, nil
+}
+
+// GetSnapshotWithVolumeID get snapshot
+func (vpcs *VPCSession) GetSnapshotWithVolumeID(volumeID string, snapshotID string) (*provider.Snapshot, error) {
+	vpcs.Logger.Info("Entry GetSnapshotWithVolumeID", zap.Reflect("VolumeID", volumeID), zap.Reflect("SnapshotID", snapshotID))
+	defer vpcs.Logger.Info("Exit GetSnapshotWithVolumeID", zap.Reflect("VolumeID", volumeID), zap.Reflect("SnapshotID", snapshotID))
+
+	respSnapshot, err := vpcs.GetSnapshot(snapshotID)
 	if err != nil {
-		return nil, userError.GetUserError("StorageFindFailedWithVolumeId", err, volume.VolumeID, "Not a valid volume ID")
+		return nil, err
 	}
 
-	respSnapshot := &provider.Snapshot{
-		SnapshotID: snapshot.ID,
-		Volume:     *volume,
+	// The snapshot must have been taken from the given volume
+	if respSnapshot.VolumeID != "" && respSnapshot.VolumeID != volumeID {
+		return nil, userError.GetUserError("SnapshotVolumeMismatch", nil, snapshotID, volumeID)
 	}
 
+	volume, err := vpcs.GetVolume(volumeID)
+	if err != nil {
+		return nil, err
+	}
+
+	respSnapshot.Volume = *volume
+
 	vpcs.Logger.Info("Successfully retrieved the snapshot details", zap.Reflect("Provider snapshot", respSnapshot))
 	return respSnapshot, nil
 }
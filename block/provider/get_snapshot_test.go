@@ -45,6 +45,7 @@ func TestGetSnapshotWithVolumeID(t *testing.T) {
 		volumeID     string
 		snapshotID   string
 		baseSnapshot *models.Snapshot
+		snapshotErr  error
 		baseVolume   *models.Volume
 		setup        func()
 
@@ -55,11 +56,11 @@ func TestGetSnapshotWithVolumeID(t *testing.T) {
 		verify func(t *testing.T, snapshotResponse *provider.Snapshot, err error)
 	}{
 		{
-			testCaseName: "Not supported yet",
+			testCaseName: "Snapshot found for the volume",
 			volumeID:     "v6f293bf-test-4bff-816f-e199c0c65db5",
 			snapshotID:   "16f293bf-test-4bff-816f-e199c0c65db5",
 			baseVolume: &models.Volume{
-				ID:       "16f293bf-test-4bff-816f-e199c0c65db5",
+				ID:       "v6f293bf-test-4bff-816f-e199c0c65db5",
 				Name:     "test-volume-name",
 				Status:   models.StatusType("OK"),
 				Capacity: int64(10),
@@ -67,22 +68,46 @@ func TestGetSnapshotWithVolumeID(t *testing.T) {
 				Zone:     &models.Zone{Name: "test-zone"},
 			},
 			baseSnapshot: &models.Snapshot{
-				ID:     "16f293bf-test-4bff-816f-e199c0c65db5",
-				Name:   "test-snapshot-name",
-				Status: models.StatusType("OK"),
+				ID:           "16f293bf-test-4bff-816f-e199c0c65db5",
+				Name:         "test-snapshot-name",
+				Status:       models.StatusType("OK"),
+				SourceVolume: &models.Volume{ID: "v6f293bf-test-4bff-816f-e199c0c65db5"},
 			},
 			verify: func(t *testing.T, snapshotResponse *provider.Snapshot, err error) {
 				assert.NotNil(t, snapshotResponse)
 				assert.Nil(t, err)
+				assert.Equal(t, "16f293bf-test-4bff-816f-e199c0c65db5", snapshotResponse.SnapshotID)
+				assert.Equal(t, "v6f293bf-test-4bff-816f-e199c0c65db5", snapshotResponse.VolumeID)
+				assert.Equal(t, "test-zone", snapshotResponse.Az)
 			},
 		}, {
-			testCaseName:       "Wrong volume ID",
-			volumeID:           "Wrong volume ID",
-			expectedErr:        "{Code:ErrorUnclassified, Type:InvalidRequest, Description:'Wrong volume ID' volume ID is not valid. Please check https://cloud.ibm.com/docs/infrastructure/vpc?topic=vpc-rias-error-messages#volume_id_invalid, BackendError:, RC:400}",
+			testCaseName: "Snapshot belongs to another volume",
+			volumeID:     "v6f293bf-test-4bff-816f-e199c0c65db5",
+			snapshotID:   "16f293bf-test-4bff-816f-e199c0c65db5",
+			baseSnapshot: &models.Snapshot{
+				ID:           "16f293bf-test-4bff-816f-e199c0c65db5",
+				Name:         "test-snapshot-name",
+				Status:       models.StatusType("OK"),
+				SourceVolume: &models.Volume{ID: "a6f293bf-test-4bff-816f-e199c0c65db5"},
+			},
+			verify: func(t *testing.T, snapshotResponse *provider.Snapshot, err error) {
+				assert.Nil(t, snapshotResponse)
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), "SnapshotVolumeMismatch")
+				}
+			},
+		}, {
+			testCaseName:       "Wrong snapshot ID",
+			volumeID:           "v6f293bf-test-4bff-816f-e199c0c65db5",
+			snapshotID:         "Wrong snapshot ID",
+			snapshotErr:        &models.Error{Errors: []models.ErrorItem{{Code: models.ErrorCodeNotFound, Message: "snapshot not found"}}},
+			expectedErr:        "{Code:SnapshotIDNotFound, Type:RetrivalFailed, Description:A snapshot with the specified snapshot ID 'Wrong snapshot ID' could not be found.",
 			expectedReasonCode: "ErrorUnclassified",
 			verify: func(t *testing.T, snapshotResponse *provider.Snapshot, err error) {
 				assert.Nil(t, snapshotResponse)
-				assert.NotNil(t, err)
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), "SnapshotIDNotFound")
+				}
 			},
 		},
 	}
@@ -104,13 +129,17 @@ func TestGetSnapshotWithVolumeID(t *testing.T) {
 			uc.VolumeServiceReturns(volumeService)
 
 			if testcase.expectedErr != "" {
-				snapshotService.GetSnapshotReturns(testcase.baseSnapshot, errors.New(testcase.expectedReasonCode))
+				snapshotErr := testcase.snapshotErr
+				if snapshotErr == nil {
+					snapshotErr = errors.New(testcase.expectedReasonCode)
+				}
+				snapshotService.GetSnapshotByIDReturns(testcase.baseSnapshot, snapshotErr)
 				volumeService.GetVolumeReturns(testcase.baseVolume, errors.New(testcase.expectedReasonCode))
 			} else {
-				snapshotService.GetSnapshotReturns(testcase.baseSnapshot, nil)
+				snapshotService.GetSnapshotByIDReturns(testcase.baseSnapshot, nil)
 				volumeService.GetVolumeReturns(testcase.baseVolume, nil)
 			}
-			snapshot, err := vpcs.GetSnapshotWithVolumeID(testcase.snapshotID, testcase.volumeID)
+			snapshot, err := vpcs.GetSnapshotWithVolumeID(testcase.volumeID, testcase.snapshotID)
 			logger.Info("Snapshot details", zap.Reflect("snapshot", snapshot))
 
 			if testcase.expectedErr != "" {
@@ -140,6 +169,7 @@ func TestGetSnapshot(t *testing.T) {
 
 		snapshotID   string
 		baseSnapshot *models.Snapshot
+		snapshotErr  error
 		setup        func()
 
 		skipErrTest        bool
@@ -149,11 +179,52 @@ func TestGetSnapshot(t *testing.T) {
 		verify func(t *testing.T, snapshotResponse *provider.Snapshot, err error)
 	}{
 		{
-			testCaseName: "Not supported yet",
+			testCaseName: "Snapshot found",
 			snapshotID:   "16f293bf-test-4bff-816f-e199c0c65db5",
+			baseSnapshot: &models.Snapshot{
+				ID:           "16f293bf-test-4bff-816f-e199c0c65db5",
+				Name:         "test-snapshot-name",
+				Status:       models.StatusType("stable"),
+				Size:         int64(10),
+				SourceVolume: &models.Volume{ID: "v6f293bf-test-4bff-816f-e199c0c65db5"},
+			},
 			verify: func(t *testing.T, snapshotResponse *provider.Snapshot, err error) {
-				assert.Nil(t, snapshotResponse)
+				assert.NotNil(t, snapshotResponse)
 				assert.Nil(t, err)
+				assert.Equal(t, "16f293bf-test-4bff-816f-e199c0c65db5", snapshotResponse.SnapshotID)
+				assert.Equal(t, "v6f293bf-test-4bff-816f-e199c0c65db5", snapshotResponse.VolumeID)
+				assert.Equal(t, 10, *snapshotResponse.SnapshotSize)
+			},
+		}, {
+			testCaseName: "Snapshot ID is empty",
+			verify: func(t *testing.T, snapshotResponse *provider.Snapshot, err error) {
+				assert.Nil(t, snapshotResponse)
+				assert.NotNil(t, err)
+			},
+		}, {
+			testCaseName:       "Snapshot not found",
+			snapshotID:         "16f293bf-test-4bff-816f-e199c0c65db5",
+			snapshotErr:        &models.Error{Errors: []models.ErrorItem{{Code: models.ErrorCodeNotFound, Message: "snapshot not found"}}},
+			expectedErr:        "{Code:SnapshotIDNotFound, Type:RetrivalFailed, Description:A snapshot with the specified snapshot ID",
+			expectedReasonCode: "ErrorUnclassified",
+			verify: func(t *testing.T, snapshotResponse *provider.Snapshot, err error) {
+				assert.Nil(t, snapshotResponse)
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), "SnapshotIDNotFound")
+				}
+			},
+		}, {
+			testCaseName:       "Snapshot can not be retrieved",
+			snapshotID:         "16f293bf-test-4bff-816f-e199c0c65db5",
+			snapshotErr:        &models.Error{Errors: []models.ErrorItem{{Code: "internal_error", Message: "internal error"}}, StatusCode: 400},
+			expectedErr:        "{Code:RetrieveSnapshotFailed, Type:RetrivalFailed, Description:Failed to retrieve the snapshot with the specified snapshot ID",
+			expectedReasonCode: "ErrorUnclassified",
+			verify: func(t *testing.T, snapshotResponse *provider.Snapshot, err error) {
+				assert.Nil(t, snapshotResponse)
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), "RetrieveSnapshotFailed")
+					assert.Contains(t, err.Error(), "internal error")
+				}
 			},
 		},
 	}
@@ -171,9 +242,13 @@ func TestGetSnapshot(t *testing.T) {
 			uc.SnapshotServiceReturns(snapshotService)
 
 			if testcase.expectedErr != "" {
-				snapshotService.GetSnapshotReturns(testcase.baseSnapshot, errors.New(testcase.expectedReasonCode))
+				snapshotErr := testcase.snapshotErr
+				if snapshotErr == nil {
+					snapshotErr = errors.New(testcase.expectedReasonCode)
+				}
+				snapshotService.GetSnapshotByIDReturns(testcase.baseSnapshot, snapshotErr)
 			} else {
-				snapshotService.GetSnapshotReturns(testcase.baseSnapshot, nil)
+				snapshotService.GetSnapshotByIDReturns(testcase.baseSnapshot, nil)
 			}
 			snapshot, err := vpcs.GetSnapshot(testcase.snapshotID)
 			logger.Info("Snapshot details", zap.Reflect("snapshot", snapshot))
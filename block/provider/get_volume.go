@@ -39,8 +39,8 @@ func (vpcs *VPCSession) GetVolume(id string) (respVolume *provider.Volume, err e
 	vpcs.Logger.Info("Getting volume details from VPC provider...", zap.Reflect("VolumeID", id))
 
 	var volume *models.Volume
-	err = retry(vpcs.Logger, func() error {
-		volume, err = vpcs.Apiclient.VolumeService().GetVolume(id, vpcs.Logger)
+	err = vpcs.APIRetry.Retry(vpcs.GetContext(), vpcs.Logger, func() error {
+		volume, err = vpcs.Apiclient.WithContext(vpcs.GetContext()).VolumeService().GetVolume(id, vpcs.Logger)
 		return err
 	})
 
@@ -69,8 +69,8 @@ func (vpcs *VPCSession) GetVolumeByName(name string) (respVolume *provider.Volum
 	vpcs.Logger.Info("Getting volume details from VPC provider...", zap.Reflect("VolumeName", name))
 
 	var volume *models.Volume
-	err = retry(vpcs.Logger, func() error {
-		volume, err = vpcs.Apiclient.VolumeService().GetVolumeByName(name, vpcs.Logger)
+	err = vpcs.APIRetry.Retry(vpcs.GetContext(), vpcs.Logger, func() error {
+		volume, err = vpcs.Apiclient.WithContext(vpcs.GetContext()).VolumeService().GetVolumeByName(name, vpcs.Logger)
 		return err
 	})
 
@@ -55,13 +55,13 @@ func (vpcs *VPCSession) getVolumeAttachmentByID(volumeAttachmentRequest models.V
 	vpcs.Logger.Info("Getting VolumeAttachment from VPC provider...")
 	var err error
 	var volumeAttachmentResult *models.VolumeAttachment
-	/*err = retry(vpcs.Logger, func() error {
-		volumeAttachmentResult, err = vpcs.APIClientVolAttachMgr.GetVolumeAttachment(&volumeAttachmentRequest, vpcs.Logger)
+	/*err = vpcs.APIRetry.Retry(vpcs.GetContext(), vpcs.Logger, func() error {
+		volumeAttachmentResult, err = vpcs.APIClientVolAttachMgr.WithContext(vpcs.GetContext()).GetVolumeAttachment(&volumeAttachmentRequest, vpcs.Logger)
 		return err
 	})*/
 
-	err = vpcs.APIRetry.FlexyRetry(vpcs.Logger, func() (error, bool) {
-		volumeAttachmentResult, err = vpcs.APIClientVolAttachMgr.GetVolumeAttachment(&volumeAttachmentRequest, vpcs.Logger)
+	err = vpcs.APIRetry.FlexyRetry(vpcs.GetContext(), vpcs.Logger, func() (error, bool) {
+		volumeAttachmentResult, err = vpcs.APIClientVolAttachMgr.WithContext(vpcs.GetContext()).GetVolumeAttachment(&volumeAttachmentRequest, vpcs.Logger)
 		// Keep retry, until we get the proper volumeAttachmentRequest object
 		if err != nil {
 			return err, skipRetryForObviousErrors(err, vpcs.Config.VPCConfig.IsIKS)
@@ -86,8 +86,8 @@ func (vpcs *VPCSession) getVolumeAttachmentByVolumeID(volumeAttachmentRequest mo
 	vpcs.Logger.Info("Getting VolumeAttachmentList from VPC provider...")
 	var volumeAttachmentList *models.VolumeAttachmentList
 	var err error
-	err = vpcs.APIRetry.FlexyRetry(vpcs.Logger, func() (error, bool) {
-		volumeAttachmentList, err = vpcs.APIClientVolAttachMgr.ListVolumeAttachments(&volumeAttachmentRequest, vpcs.Logger)
+	err = vpcs.APIRetry.FlexyRetry(vpcs.GetContext(), vpcs.Logger, func() (error, bool) {
+		volumeAttachmentList, err = vpcs.APIClientVolAttachMgr.WithContext(vpcs.GetContext()).ListVolumeAttachments(&volumeAttachmentRequest, vpcs.Logger)
 		// Keep retry, until we get the proper volumeAttachmentRequest object
 		if err != nil {
 			return err, skipRetryForObviousErrors(err, vpcs.Config.VPCConfig.IsIKS)
@@ -22,10 +22,15 @@ import (
 	"go.uber.org/zap"
 )
 
-// ListAllSnapshots list all snapshots
+// ListAllSnapshots list all snapshots of the given volume
 func (vpcs *VPCSession) ListAllSnapshots(volumeID string) ([]*provider.Snapshot, error) {
 	vpcs.Logger.Info("Entry ListAllSnapshots", zap.Reflect("VolumeID", volumeID))
 	defer vpcs.Logger.Info("Exit ListAllSnapshots", zap.Reflect("VolumeID", volumeID))
 
-	return nil, nil
+	err := validateVolumeID(volumeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return vpcs.ListSnapshotsWithFilters(map[string]string{"source_volume.id": volumeID})
 }
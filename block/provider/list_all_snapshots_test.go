@@ -23,6 +23,8 @@ import (
 	"github.com/IBM/ibmcloud-volume-interface/lib/provider"
 	util "github.com/IBM/ibmcloud-volume-interface/lib/utils"
 	"github.com/IBM/ibmcloud-volume-interface/lib/utils/reasoncode"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
+	snapshotServiceFakes "github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/vpcvolume/fakes"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
 )
@@ -32,9 +34,14 @@ func TestListAllSnapshots(t *testing.T) {
 	logger, teardown := GetTestLogger(t)
 	defer teardown()
 
+	var (
+		snapshotService *snapshotServiceFakes.SnapshotService
+	)
+
 	testCases := []struct {
 		testCaseName string
 		volumeID     string
+		snapshotList *models.SnapshotList
 
 		setup func()
 
@@ -45,11 +52,26 @@ func TestListAllSnapshots(t *testing.T) {
 		verify func(t *testing.T, volumes []*provider.Snapshot, err error)
 	}{
 		{
-			testCaseName: "Not supported yet",
+			testCaseName: "Snapshots of the volume listed",
 			volumeID:     "16f293bf-test-4bff-816f-e199c0c65db5",
+			snapshotList: &models.SnapshotList{
+				Snapshots: []*models.Snapshot{
+					{ID: "snapshot1", SourceVolume: &models.Volume{ID: "16f293bf-test-4bff-816f-e199c0c65db5"}},
+					{ID: "snapshot2", SourceVolume: &models.Volume{ID: "16f293bf-test-4bff-816f-e199c0c65db5"}},
+				},
+			},
 			verify: func(t *testing.T, snapshots []*provider.Snapshot, err error) {
-				assert.Nil(t, snapshots)
 				assert.Nil(t, err)
+				assert.Equal(t, 2, len(snapshots))
+				_, _, filters, _ := snapshotService.ListAllSnapshotsArgsForCall(0)
+				assert.Equal(t, "16f293bf-test-4bff-816f-e199c0c65db5", filters.SourceVolumeID)
+			},
+		}, {
+			testCaseName: "Wrong volume ID",
+			volumeID:     "Wrong volume ID",
+			verify: func(t *testing.T, snapshots []*provider.Snapshot, err error) {
+				assert.Nil(t, snapshots)
+				assert.NotNil(t, err)
 			},
 		},
 	}
@@ -62,6 +84,11 @@ func TestListAllSnapshots(t *testing.T) {
 			assert.NotNil(t, sc)
 			assert.Nil(t, err)
 
+			snapshotService = &snapshotServiceFakes.SnapshotService{}
+			assert.NotNil(t, snapshotService)
+			uc.SnapshotServiceReturns(snapshotService)
+			snapshotService.ListAllSnapshotsReturns(testcase.snapshotList, nil)
+
 			snapshots, err := vpcs.ListAllSnapshots(testcase.volumeID)
 			logger.Info("Snapshots details", zap.Reflect("Snapshots", snapshots))
 
@@ -18,13 +18,84 @@
 package provider
 
 import (
+	"time"
+
+	"github.com/IBM/ibmcloud-volume-interface/lib/metrics"
 	"github.com/IBM/ibmcloud-volume-interface/lib/provider"
+	userError "github.com/IBM/ibmcloud-volume-vpc/common/messages"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
+	"go.uber.org/zap"
 )
 
+// listSnapshotsFilterKeys are the filter keys accepted by ListSnapshotsWithFilters
+var listSnapshotsFilterKeys = map[string]struct{}{
+	"resource_group.id": {},
+	"name":              {},
+	"tag":               {},
+	"source_volume.id":  {},
+}
+
 // ListSnapshots list all snapshots
 func (vpcs *VPCSession) ListSnapshots() ([]*provider.Snapshot, error) {
-	vpcs.Logger.Info("Entry ListeSnapshots")
+	vpcs.Logger.Info("Entry ListSnapshots")
 	defer vpcs.Logger.Info("Exit ListSnapshots")
 
-	return nil, nil
+	return vpcs.ListSnapshotsWithFilters(nil)
+}
+
+// ListSnapshotsWithFilters list all snapshots matching the given filters, following the pages returned by the backend.
+// Supported filter keys are "resource_group.id", "name", "tag" and "source_volume.id"
+func (vpcs *VPCSession) ListSnapshotsWithFilters(tags map[string]string) ([]*provider.Snapshot, error) {
+	vpcs.Logger.Info("Entry ListSnapshotsWithFilters", zap.Reflect("filters", tags))
+	defer vpcs.Logger.Info("Exit ListSnapshotsWithFilters", zap.Reflect("filters", tags))
+	defer metrics.UpdateDurationFromStart(vpcs.Logger, "ListSnapshots", time.Now())
+
+	for key := range tags {
+		if _, ok := listSnapshotsFilterKeys[key]; !ok {
+			return nil, userError.GetUserError("InvalidListFilter", nil, key, "resource_group.id, name, tag, source_volume.id")
+		}
+	}
+
+	filters := &models.ListSnapshotFilters{
+		ResourceGroupID: tags["resource_group.id"],
+		Tag:             tags["tag"],
+		SnapshotName:    tags["name"],
+		SourceVolumeID:  tags["source_volume.id"],
+	}
+
+	vpcs.Logger.Info("Getting snapshots list from VPC provider...", zap.Reflect("filters", filters))
+
+	respSnapshots := []*provider.Snapshot{}
+	start := ""
+	for {
+		var snapshots *models.SnapshotList
+		var err error
+		err = vpcs.APIRetry.Retry(vpcs.GetContext(), vpcs.Logger, func() error {
+			snapshots, err = vpcs.Apiclient.WithContext(vpcs.GetContext()).SnapshotService().ListAllSnapshots(maxLimit, start, filters, vpcs.Logger)
+			return err
+		})
+
+		if err != nil {
+			return nil, userError.GetUserError("ListSnapshotsFailed", err)
+		}
+
+		if snapshots == nil {
+			break
+		}
+
+		for _, snapshotItem := range snapshots.Snapshots {
+			respSnapshots = append(respSnapshots, FromProviderToLibSnapshot(snapshotItem, vpcs.Logger))
+		}
+
+		if snapshots.Next == nil {
+			break
+		}
+		start = getStartFromNextHref(snapshots.Next.Href, vpcs.Logger)
+		if start == "" {
+			break
+		}
+	}
+
+	vpcs.Logger.Info("Successfully retrieved snapshots list from VPC backend", zap.Int("count", len(respSnapshots)))
+	return respSnapshots, nil
 }
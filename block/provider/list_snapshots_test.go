@@ -39,23 +39,78 @@ func TestListSnapshots(t *testing.T) {
 	)
 
 	testCases := []struct {
-		testCaseName string
-		volumeID     string
-		baseVolume   *models.Volume
+		testCaseName  string
+		filters       map[string]string
+		snapshotPages []*models.SnapshotList
+		listErr       error
 
-		setup func()
-
-		skipErrTest        bool
 		expectedErr        string
 		expectedReasonCode string
 
-		verify func(t *testing.T, volumes []*provider.Snapshot, err error)
+		verify func(t *testing.T, snapshots []*provider.Snapshot, err error)
 	}{
 		{
-			testCaseName: "Not supported yet",
+			testCaseName: "No snapshots",
+			snapshotPages: []*models.SnapshotList{
+				{},
+			},
+			verify: func(t *testing.T, snapshots []*provider.Snapshot, err error) {
+				assert.Nil(t, err)
+				assert.NotNil(t, snapshots)
+				assert.Equal(t, 0, len(snapshots))
+			},
+		}, {
+			testCaseName: "Snapshots listed across pages",
+			snapshotPages: []*models.SnapshotList{
+				{
+					Snapshots: []*models.Snapshot{{ID: "snapshot1", Size: 10, SourceVolume: &models.Volume{ID: "volume1"}}},
+					Next:      &models.HReference{Href: "https://us-south.iaas.cloud.ibm.com/v1/snapshots?start=snapshot2&limit=1"},
+				},
+				{
+					Snapshots: []*models.Snapshot{{ID: "snapshot2", Size: 20, SourceVolume: &models.Volume{ID: "volume2"}}},
+				},
+			},
+			verify: func(t *testing.T, snapshots []*provider.Snapshot, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, 2, len(snapshots))
+				assert.Equal(t, "snapshot1", snapshots[0].SnapshotID)
+				assert.Equal(t, "volume2", snapshots[1].VolumeID)
+				assert.Equal(t, 20, *snapshots[1].SnapshotSize)
+				assert.Equal(t, 2, snapshotService.ListAllSnapshotsCallCount())
+				_, start, _, _ := snapshotService.ListAllSnapshotsArgsForCall(1)
+				assert.Equal(t, "snapshot2", start)
+			},
+		}, {
+			testCaseName: "Filters are passed to the backend",
+			filters:      map[string]string{"resource_group.id": "rg1", "name": "snap", "tag": "backup"},
+			snapshotPages: []*models.SnapshotList{
+				{},
+			},
 			verify: func(t *testing.T, snapshots []*provider.Snapshot, err error) {
-				assert.Nil(t, snapshots)
 				assert.Nil(t, err)
+				_, _, filters, _ := snapshotService.ListAllSnapshotsArgsForCall(0)
+				assert.Equal(t, &models.ListSnapshotFilters{ResourceGroupID: "rg1", SnapshotName: "snap", Tag: "backup"}, filters)
+			},
+		}, {
+			testCaseName:       "Unknown filter key",
+			filters:            map[string]string{"zone.name": "test-zone"},
+			expectedErr:        "{Code:InvalidListFilter, Type:InvalidRequest, Description:The filter 'zone.name' is not supported by the list call.",
+			expectedReasonCode: "ErrorUnclassified",
+			verify: func(t *testing.T, snapshots []*provider.Snapshot, err error) {
+				assert.Nil(t, snapshots)
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), "InvalidListFilter")
+				}
+				assert.Equal(t, 0, snapshotService.ListAllSnapshotsCallCount())
+			},
+		}, {
+			testCaseName:       "Snapshot list failed",
+			listErr:            &models.Error{Errors: []models.ErrorItem{{Code: "not_found", Message: "not found"}}},
+			expectedErr:        "{Code:ListSnapshotsFailed, Type:RetrivalFailed, Description:Unable to fetch list of snapshots.",
+			expectedReasonCode: "ErrorUnclassified",
+			verify: func(t *testing.T, snapshots []*provider.Snapshot, err error) {
+				assert.Nil(t, snapshots)
+				assert.NotNil(t, err)
 			},
 		},
 	}
@@ -72,9 +127,19 @@ func TestListSnapshots(t *testing.T) {
 			assert.NotNil(t, snapshotService)
 			uc.SnapshotServiceReturns(snapshotService)
 
-			snapshotService.ListSnapshotsReturns(nil, nil)
+			if testcase.listErr != nil {
+				snapshotService.ListAllSnapshotsReturns(nil, testcase.listErr)
+			}
+			for i, page := range testcase.snapshotPages {
+				snapshotService.ListAllSnapshotsReturnsOnCall(i, page, nil)
+			}
 
-			snapshots, err := vpcs.ListSnapshots()
+			var snapshots []*provider.Snapshot
+			if testcase.filters != nil {
+				snapshots, err = vpcs.ListSnapshotsWithFilters(testcase.filters)
+			} else {
+				snapshots, err = vpcs.ListSnapshots()
+			}
 			logger.Info("Snapshots details", zap.Reflect("Snapshots", snapshots))
 
 			if testcase.expectedErr != "" {
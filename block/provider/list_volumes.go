@@ -18,7 +18,9 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -34,12 +36,37 @@ const (
 	startVolumeIDNotFoundMsg = "start parameter is not valid"
 )
 
+// listVolumesFilterKeys are the filter keys accepted by ListVolumes
+var listVolumesFilterKeys = map[string]struct{}{
+	"resource_group.id":     {},
+	"resource_group.name":   {},
+	"zone.name":             {},
+	"name":                  {},
+	"tag":                   {},
+	"status":                {},
+	"profile.name":          {},
+	"capacity.min":          {},
+	"capacity.max":          {},
+	models.ClusterIDTagName: {},
+}
+
+const supportedListVolumesFilters = "resource_group.id, resource_group.name, zone.name, name, tag, status, profile.name, capacity.min, capacity.max, clusterid"
+
 // ListVolumes list all volumes
+// "resource_group.id", "zone.name", "name" and "tag" filters are applied by the backend, "resource_group.name" is
+// resolved to "resource_group.id" unless the ID is given as well. "status", "profile.name",
+// "capacity.min", "capacity.max" (in GiB) and "clusterid" are applied on each returned page, so a page
+// may contain less than limit volumes even though there are more pages
 func (vpcs *VPCSession) ListVolumes(limit int, start string, tags map[string]string) (*provider.VolumeList, error) {
 	vpcs.Logger.Info("Entry ListVolumes", zap.Reflect("start", start), zap.Reflect("filters", tags))
 	defer vpcs.Logger.Info("Exit ListVolumes", zap.Reflect("start", start), zap.Reflect("filters", tags))
 	defer metrics.UpdateDurationFromStart(vpcs.Logger, "ListVolumes", time.Now())
 
+	return vpcs.listVolumes(vpcs.GetContext(), limit, start, tags)
+}
+
+// listVolumes lists one page of volumes, ctx bounds the backend calls and their retries
+func (vpcs *VPCSession) listVolumes(ctx context.Context, limit int, start string, tags map[string]string) (*provider.VolumeList, error) {
 	if limit < 0 {
 		return nil, userError.GetUserError("InvalidListVolumesLimit", nil, limit)
 	}
@@ -49,19 +76,29 @@ func (vpcs *VPCSession) ListVolumes(limit int, start string, tags map[string]str
 		limit = maxLimit
 	}
 
+	isMatching, err := newListVolumesFilter(tags)
+	if err != nil {
+		return nil, err
+	}
+
 	filters := &models.ListVolumeFilters{
-		// Tag:          tags["tag"],
+		Tag:             tags["tag"],
 		ResourceGroupID: tags["resource_group.id"],
 		ZoneName:        tags["zone.name"],
 		VolumeName:      tags["name"],
 	}
+	if len(filters.ResourceGroupID) == 0 && len(tags["resource_group.name"]) > 0 {
+		filters.ResourceGroupID, err = resolveResourceGroupID(ctx, vpcs.APIRetry, vpcs.Apiclient.WithContext(ctx).ResourceGroupService(), tags["resource_group.name"], vpcs.VPCAccountID, vpcs.Logger)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	vpcs.Logger.Info("Getting volumes list from VPC provider...", zap.Reflect("start", start), zap.Reflect("filters", filters))
 
 	var volumes *models.VolumeList
-	var err error
-	err = retry(vpcs.Logger, func() error {
-		volumes, err = vpcs.Apiclient.VolumeService().ListVolumes(limit, start, filters, vpcs.Logger)
+	err = vpcs.APIRetry.Retry(ctx, vpcs.Logger, func() error {
+		volumes, err = vpcs.Apiclient.WithContext(ctx).VolumeService().ListVolumes(limit, start, filters, vpcs.Logger)
 		return err
 	})
 
@@ -77,19 +114,15 @@ func (vpcs *VPCSession) ListVolumes(limit int, start string, tags map[string]str
 	var respVolumesList = &provider.VolumeList{}
 	if volumes != nil {
 		if volumes.Next != nil {
-			var next string
-			// "Next":{"href":"https://eu-gb.iaas.cloud.ibm.com/v1/volumes?start=3e898aa7-ac71-4323-952d-a8d741c65a68\u0026limit=1\u0026zone.name=eu-gb-1"}
-			if strings.Contains(volumes.Next.Href, "start=") {
-				next = strings.Split(strings.Split(volumes.Next.Href, "start=")[1], "\u0026")[0]
-			} else {
-				vpcs.Logger.Warn("Volumes.Next.Href is not in expected format", zap.Reflect("volumes.Next.Href", volumes.Next.Href))
-			}
-			respVolumesList.Next = next
+			respVolumesList.Next = getStartFromNextHref(volumes.Next.Href, vpcs.Logger)
 		}
 
 		volumeslist := volumes.Volumes
 		if len(volumeslist) > 0 {
 			for _, volItem := range volumeslist {
+				if !isMatching(volItem) {
+					continue
+				}
 				volumeResponse := FromProviderToLibVolume(volItem, vpcs.Logger)
 				respVolumesList.Volumes = append(respVolumesList.Volumes, volumeResponse)
 			}
@@ -97,3 +130,59 @@ func (vpcs *VPCSession) ListVolumes(limit int, start string, tags map[string]str
 	}
 	return respVolumesList, err
 }
+
+// newListVolumesFilter validates the list filters and returns the matcher for the filters which are not supported by the backend
+func newListVolumesFilter(tags map[string]string) (func(volume *models.Volume) bool, error) {
+	for key := range tags {
+		if _, ok := listVolumesFilterKeys[key]; !ok {
+			return nil, userError.GetUserError("InvalidListFilter", nil, key, supportedListVolumesFilters)
+		}
+	}
+
+	var minCapacity, maxCapacity int64
+	for key, capacity := range map[string]*int64{"capacity.min": &minCapacity, "capacity.max": &maxCapacity} {
+		value, ok := tags[key]
+		if !ok {
+			continue
+		}
+		parsed, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || parsed < 0 {
+			return nil, userError.GetUserError("InvalidListFilterValue", err, value, key)
+		}
+		*capacity = parsed
+	}
+
+	status, isStatus := tags["status"]
+	profileName, isProfileName := tags["profile.name"]
+	clusterID, isClusterID := tags[models.ClusterIDTagName]
+	_, isMaxCapacity := tags["capacity.max"]
+
+	return func(volume *models.Volume) bool {
+		if volume == nil {
+			return false
+		}
+		if isStatus && string(volume.Status) != status {
+			return false
+		}
+		if isProfileName && (volume.Profile == nil || volume.Profile.Name != profileName) {
+			return false
+		}
+		if volume.Capacity < minCapacity || (isMaxCapacity && volume.Capacity > maxCapacity) {
+			return false
+		}
+		if isClusterID && volume.Cluster != clusterID && !hasTag(volume.Tags, models.ClusterIDTagName+":"+clusterID) {
+			return false
+		}
+		return true
+	}, nil
+}
+
+// hasTag checks if the tag is part of the volume tags
+func hasTag(volumeTags []string, tag string) bool {
+	for _, volumeTag := range volumeTags {
+		if volumeTag == tag {
+			return true
+		}
+	}
+	return false
+}
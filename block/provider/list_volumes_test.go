@@ -355,3 +355,134 @@ func TestListVolumes(t *testing.T) {
 		})
 	}
 }
+
+func TestListVolumesWithFilters(t *testing.T) {
+	//var err error
+	logger, teardown := GetTestLogger(t)
+	defer teardown()
+
+	var (
+		volumeService *volumeServiceFakes.VolumeService
+	)
+
+	volumeList := &models.VolumeList{
+		Limit: 50,
+		Volumes: []*models.Volume{
+			{
+				ID:       "16f293bf-test-4bff-816f-e199c0c65db5",
+				Name:     "test-volume-name1",
+				Status:   models.StatusType("available"),
+				Capacity: int64(10),
+				Profile:  &models.Profile{Name: "general-purpose"},
+				Tags:     []string{"clusterid:test-cluster-1"},
+				Zone:     &models.Zone{Name: "test-zone-1"},
+			}, {
+				ID:       "23b154fr-test-4bff-816f-f213s1y34gj8",
+				Name:     "test-volume-name2",
+				Status:   models.StatusType("pending"),
+				Capacity: int64(100),
+				Profile:  &models.Profile{Name: "custom"},
+				Cluster:  "test-cluster-2",
+				Zone:     &models.Zone{Name: "test-zone-1"},
+			}, {
+				ID:       "33c154fr-test-4bff-816f-f213s1y34gj9",
+				Name:     "test-volume-name3",
+				Status:   models.StatusType("available"),
+				Capacity: int64(1000),
+				Zone:     &models.Zone{Name: "test-zone-2"},
+			},
+		},
+	}
+
+	testCases := []struct {
+		testCaseName string
+		tags         map[string]string
+
+		expectedVolumeIDs []string
+		expectedTag       string
+		expectedErr       string
+	}{
+		{
+			testCaseName:      "Filter by tag is forwarded to the backend",
+			tags:              map[string]string{"tag": "env:test"},
+			expectedTag:       "env:test",
+			expectedVolumeIDs: []string{"16f293bf-test-4bff-816f-e199c0c65db5", "23b154fr-test-4bff-816f-f213s1y34gj8", "33c154fr-test-4bff-816f-f213s1y34gj9"},
+		}, {
+			testCaseName:      "Filter by status",
+			tags:              map[string]string{"status": "available"},
+			expectedVolumeIDs: []string{"16f293bf-test-4bff-816f-e199c0c65db5", "33c154fr-test-4bff-816f-f213s1y34gj9"},
+		}, {
+			testCaseName:      "Filter by profile name",
+			tags:              map[string]string{"profile.name": "custom"},
+			expectedVolumeIDs: []string{"23b154fr-test-4bff-816f-f213s1y34gj8"},
+		}, {
+			testCaseName:      "Filter by capacity range",
+			tags:              map[string]string{"capacity.min": "50", "capacity.max": "500"},
+			expectedVolumeIDs: []string{"23b154fr-test-4bff-816f-f213s1y34gj8"},
+		}, {
+			testCaseName:      "Filter by minimum capacity",
+			tags:              map[string]string{"capacity.min": "100"},
+			expectedVolumeIDs: []string{"23b154fr-test-4bff-816f-f213s1y34gj8", "33c154fr-test-4bff-816f-f213s1y34gj9"},
+		}, {
+			testCaseName:      "Filter by cluster ID tag",
+			tags:              map[string]string{"clusterid": "test-cluster-1"},
+			expectedVolumeIDs: []string{"16f293bf-test-4bff-816f-e199c0c65db5"},
+		}, {
+			testCaseName:      "Filter by cluster ID",
+			tags:              map[string]string{"clusterid": "test-cluster-2", "status": "pending"},
+			expectedVolumeIDs: []string{"23b154fr-test-4bff-816f-f213s1y34gj8"},
+		}, {
+			testCaseName: "No volume matching the filters",
+			tags:         map[string]string{"status": "available", "profile.name": "custom"},
+		}, {
+			testCaseName: "Invalid capacity filter",
+			tags:         map[string]string{"capacity.max": "ten"},
+			expectedErr:  "InvalidListFilterValue",
+		}, {
+			testCaseName: "Unknown filter",
+			tags:         map[string]string{"size": "10"},
+			expectedErr:  "InvalidListFilter",
+		},
+	}
+
+	for _, testcase := range testCases {
+		t.Run(testcase.testCaseName, func(t *testing.T) {
+			vpcs, uc, sc, err := GetTestOpenSession(t, logger)
+			assert.NotNil(t, vpcs)
+			assert.NotNil(t, uc)
+			assert.NotNil(t, sc)
+			assert.Nil(t, err)
+
+			volumeService = &volumeServiceFakes.VolumeService{}
+			assert.NotNil(t, volumeService)
+			uc.VolumeServiceReturns(volumeService)
+
+			volumeService.ListVolumesReturns(volumeList, nil)
+			volumes, err := vpcs.ListVolumes(50, "", testcase.tags)
+			logger.Info("VolumesList details", zap.Reflect("VolumesList", volumes))
+
+			if testcase.expectedErr != "" {
+				assert.Nil(t, volumes)
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), testcase.expectedErr)
+				}
+				assert.Equal(t, 0, volumeService.ListVolumesCallCount())
+				return
+			}
+
+			assert.Nil(t, err)
+			_, _, filters, _ := volumeService.ListVolumesArgsForCall(0)
+			assert.Equal(t, testcase.expectedTag, filters.Tag)
+
+			volumeIDs := []string{}
+			for _, volume := range volumes.Volumes {
+				volumeIDs = append(volumeIDs, volume.VolumeID)
+			}
+			if len(testcase.expectedVolumeIDs) == 0 {
+				assert.Empty(t, volumeIDs)
+			} else {
+				assert.Equal(t, testcase.expectedVolumeIDs, volumeIDs)
+			}
+		})
+	}
+}
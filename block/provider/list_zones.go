@@ -0,0 +1,108 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package provider ...
+package provider
+
+import (
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/IBM/ibmcloud-volume-interface/lib/metrics"
+	userError "github.com/IBM/ibmcloud-volume-vpc/common/messages"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
+	"go.uber.org/zap"
+)
+
+// zoneStatusAvailable is the status of a zone in which volumes can be created
+const zoneStatusAvailable = "available"
+
+// ListZones returns the names of the available zones in the region of the configured endpoint
+func (vpcs *VPCSession) ListZones() ([]string, error) {
+	vpcs.Logger.Debug("Entry of ListZones method...")
+	defer vpcs.Logger.Debug("Exit from ListZones method...")
+	defer metrics.UpdateDurationFromStart(vpcs.Logger, "ListZones", time.Now())
+
+	regionName := vpcs.getRegionName()
+	if len(regionName) == 0 {
+		return nil, userError.GetUserError("RegionNotFound", nil, vpcs.getEndpointURL())
+	}
+
+	var zones *models.ZoneList
+	var err error
+	err = vpcs.APIRetry.Retry(vpcs.GetContext(), vpcs.Logger, func() error {
+		zones, err = vpcs.Apiclient.WithContext(vpcs.GetContext()).RegionService().ListZones(regionName, vpcs.Logger)
+		return err
+	})
+	if err != nil {
+		return nil, userError.GetUserError("ListZonesFailed", err, regionName)
+	}
+
+	zoneNames := []string{}
+	if zones != nil {
+		for _, zone := range zones.Zones {
+			if zone != nil && (len(zone.Status) == 0 || zone.Status == zoneStatusAvailable) {
+				zoneNames = append(zoneNames, zone.Name)
+			}
+		}
+	}
+	vpcs.Logger.Info("Available zones", zap.Reflect("RegionName", regionName), zap.Reflect("Zones", zoneNames))
+	return zoneNames, nil
+}
+
+// validateZone checks that the zone is one of the available zones of the region. If the zones can not be
+// listed the validation is left to the backend
+func validateZone(vpcs *VPCSession, zone string) error {
+	if len(zone) == 0 {
+		return nil
+	}
+	zoneNames, err := vpcs.ListZones()
+	if err != nil {
+		vpcs.Logger.Warn("Unable to list the zones, skipping the zone validation", zap.Reflect("Zone", zone), zap.Error(err))
+		return nil
+	}
+	if len(zoneNames) == 0 {
+		return nil
+	}
+	for _, zoneName := range zoneNames {
+		if zoneName == zone {
+			return nil
+		}
+	}
+	return userError.GetUserError("InvalidZone", nil, zone, vpcs.getRegionName(), strings.Join(zoneNames, ", "))
+}
+
+// getEndpointURL returns the configured RIaaS endpoint of the session
+func (vpcs *VPCSession) getEndpointURL() string {
+	if vpcs.Config == nil || vpcs.Config.VPCConfig == nil {
+		return ""
+	}
+	return vpcs.Config.VPCConfig.EndpointURL
+}
+
+// getRegionName derives the region from the configured endpoint, e.g. us-south for https://private-us-south.iaas.cloud.ibm.com
+func (vpcs *VPCSession) getRegionName() string {
+	endpoint, err := url.Parse(vpcs.getEndpointURL())
+	if err != nil {
+		return ""
+	}
+	host := strings.TrimPrefix(endpoint.Hostname(), PrivatePrefix)
+	// The region is the first label of a fully qualified endpoint host name
+	if i := strings.Index(host, "."); i > 0 {
+		return host[:i]
+	}
+	return ""
+}
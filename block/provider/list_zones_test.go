@@ -0,0 +1,200 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package provider ...
+package provider
+
+import (
+	"testing"
+
+	"github.com/IBM/ibmcloud-volume-interface/lib/provider"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
+	regionFakes "github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/regions/fakes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListZones(t *testing.T) {
+	//var err error
+	logger, teardown := GetTestLogger(t)
+	defer teardown()
+
+	usSouthZones := &models.ZoneList{
+		Zones: []*models.Zone{
+			{Name: "us-south-1", Status: "available"},
+			{Name: "us-south-2", Status: "impaired"},
+			{Name: "us-south-3"},
+		},
+	}
+
+	testCases := []struct {
+		testCaseName string
+		endpointURL  string
+		zones        *models.ZoneList
+		zonesErr     error
+
+		expectedRegion string
+		expectedZones  []string
+		expectedErr    string
+	}{
+		{
+			testCaseName:   "Available zones of the public endpoint region",
+			endpointURL:    "https://us-south.iaas.cloud.ibm.com",
+			zones:          usSouthZones,
+			expectedRegion: "us-south",
+			expectedZones:  []string{"us-south-1", "us-south-3"},
+		}, {
+			testCaseName:   "Available zones of the private endpoint region",
+			endpointURL:    "https://private-us-south.iaas.cloud.ibm.com",
+			zones:          usSouthZones,
+			expectedRegion: "us-south",
+			expectedZones:  []string{"us-south-1", "us-south-3"},
+		}, {
+			testCaseName: "Region can not be derived from the endpoint",
+			endpointURL:  "http://some_endpoint",
+			expectedErr:  "RegionNotFound",
+		}, {
+			testCaseName:   "Zones listing failed",
+			endpointURL:    "https://eu-de.iaas.cloud.ibm.com",
+			zonesErr:       &models.Error{Errors: []models.ErrorItem{{Code: models.ErrorCodeNotFound, Message: "region not found"}}},
+			expectedRegion: "eu-de",
+			expectedErr:    "ListZonesFailed",
+		},
+	}
+
+	for _, testcase := range testCases {
+		t.Run(testcase.testCaseName, func(t *testing.T) {
+			vpcs, uc, sc, err := GetTestOpenSession(t, logger)
+			assert.NotNil(t, vpcs)
+			assert.NotNil(t, uc)
+			assert.NotNil(t, sc)
+			assert.Nil(t, err)
+
+			vpcs.Config.VPCConfig.EndpointURL = testcase.endpointURL
+			regionService := &regionFakes.RegionService{}
+			uc.RegionServiceReturns(regionService)
+			regionService.ListZonesReturns(testcase.zones, testcase.zonesErr)
+
+			zones, err := vpcs.ListZones()
+			if testcase.expectedErr != "" {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), testcase.expectedErr)
+				}
+				assert.Nil(t, zones)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, testcase.expectedZones, zones)
+			}
+			if testcase.expectedRegion != "" && assert.Equal(t, 1, regionService.ListZonesCallCount()) {
+				regionName, _ := regionService.ListZonesArgsForCall(0)
+				assert.Equal(t, testcase.expectedRegion, regionName)
+			}
+		})
+	}
+}
+
+func TestValidateZone(t *testing.T) {
+	//var err error
+	logger, teardown := GetTestLogger(t)
+	defer teardown()
+
+	testCases := []struct {
+		testCaseName string
+		zone         string
+		zonesErr     error
+
+		expectedErr string
+	}{
+		{
+			testCaseName: "Available zone",
+			zone:         "us-south-1",
+		}, {
+			testCaseName: "Unknown zone",
+			zone:         "us-south1",
+			expectedErr:  "InvalidZone",
+		}, {
+			testCaseName: "Zone of another region",
+			zone:         "eu-de-1",
+			expectedErr:  "InvalidZone",
+		}, {
+			testCaseName: "Zone not provided",
+		}, {
+			testCaseName: "Zones can not be listed",
+			zone:         "us-south1",
+			zonesErr:     &models.Error{Errors: []models.ErrorItem{{Code: models.ErrorCodeNotFound, Message: "region not found"}}},
+		},
+	}
+
+	for _, testcase := range testCases {
+		t.Run(testcase.testCaseName, func(t *testing.T) {
+			vpcs, uc, sc, err := GetTestOpenSession(t, logger)
+			assert.NotNil(t, vpcs)
+			assert.NotNil(t, uc)
+			assert.NotNil(t, sc)
+			assert.Nil(t, err)
+
+			vpcs.Config.VPCConfig.EndpointURL = "https://us-south.iaas.cloud.ibm.com"
+			regionService := &regionFakes.RegionService{}
+			uc.RegionServiceReturns(regionService)
+			regionService.ListZonesReturns(&models.ZoneList{Zones: []*models.Zone{{Name: "us-south-1", Status: "available"}, {Name: "us-south-2", Status: "available"}}}, testcase.zonesErr)
+
+			err = validateZone(vpcs, testcase.zone)
+			if testcase.expectedErr != "" {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), testcase.expectedErr)
+					assert.Contains(t, err.Error(), "us-south-1, us-south-2")
+				}
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestCreateVolumeInvalidZone(t *testing.T) {
+	//var err error
+	logger, teardown := GetTestLogger(t)
+	defer teardown()
+
+	vpcs, uc, sc, err := GetTestOpenSession(t, logger)
+	assert.NotNil(t, vpcs)
+	assert.NotNil(t, uc)
+	assert.NotNil(t, sc)
+	assert.Nil(t, err)
+
+	vpcs.Config.VPCConfig.EndpointURL = "https://us-south.iaas.cloud.ibm.com"
+	regionService := &regionFakes.RegionService{}
+	uc.RegionServiceReturns(regionService)
+	regionService.ListZonesReturns(&models.ZoneList{Zones: []*models.Zone{{Name: "us-south-1", Status: "available"}}}, nil)
+
+	capacity := 10
+	volumeName := "test-volume"
+	volumeRequest := provider.Volume{
+		Name:     &volumeName,
+		Capacity: &capacity,
+		Az:       "us-south1",
+		VPCVolume: provider.VPCVolume{
+			Profile:       &provider.Profile{Name: "general-purpose"},
+			ResourceGroup: &provider.ResourceGroup{ID: "default resource group id"},
+		},
+	}
+
+	volume, err := vpcs.CreateVolume(volumeRequest)
+	assert.Nil(t, volume)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "InvalidZone")
+	}
+	// The volume is not ordered with an invalid zone
+	assert.Equal(t, 0, uc.VolumeServiceCallCount())
+}
@@ -36,8 +36,8 @@ func (vpcs *VPCSession) OrderSnapshot(volumeRequest provider.Volume) error {
 	vpcs.Logger.Info("Requested volume is:", zap.Reflect("Volume", volumeRequest))
 	var volume *models.Volume
 
-	err = retry(vpcs.Logger, func() error {
-		volume, err = vpcs.Apiclient.VolumeService().GetVolume(volumeRequest.VolumeID, vpcs.Logger)
+	err = vpcs.APIRetry.Retry(vpcs.GetContext(), vpcs.Logger, func() error {
+		volume, err = vpcs.Apiclient.WithContext(vpcs.GetContext()).VolumeService().GetVolume(volumeRequest.VolumeID, vpcs.Logger)
 		return err
 	})
 	if err != nil {
@@ -45,8 +45,10 @@ func (vpcs *VPCSession) OrderSnapshot(volumeRequest provider.Volume) error {
 	}
 	vpcs.Logger.Info("Successfully retrieved given volume details from VPC provider", zap.Reflect("VolumeDetails", volume))
 
-	err = retry(vpcs.Logger, func() error {
-		snapshot, err = vpcs.Apiclient.SnapshotService().CreateSnapshot(volumeRequest.VolumeID, snapshot, vpcs.Logger)
+	snapshotTemplate := newSnapshotTemplate(volumeRequest, volume, nil)
+	vpcs.Logger.Info("Calling VPC provider for snapshot creation...", zap.Reflect("SnapshotTemplate", snapshotTemplate))
+	err = vpcs.APIRetry.Retry(vpcs.GetContext(), vpcs.Logger, func() error {
+		snapshot, err = vpcs.Apiclient.WithContext(vpcs.GetContext()).SnapshotService().CreateSnapshot(volumeRequest.VolumeID, snapshotTemplate, vpcs.Logger)
 		return err
 	})
 	if err != nil {
@@ -54,5 +56,8 @@ func (vpcs *VPCSession) OrderSnapshot(volumeRequest provider.Volume) error {
 	}
 
 	vpcs.Logger.Info("Successfully created the snapshot with backend (vpcclient) call.", zap.Reflect("Snapshot", snapshot))
-	return nil
+
+	vpcs.Logger.Info("Waiting for snapshot to be in stable state", zap.Reflect("SnapshotID", snapshot.ID))
+	_, err = WaitForSnapshotStable(vpcs, snapshot.ID)
+	return err
 }
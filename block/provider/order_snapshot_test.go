@@ -70,7 +70,7 @@ func TestOrderSnapshot(t *testing.T) {
 			baseSnapshot: &models.Snapshot{
 				ID:     "16f293bf-test-4bff-816f-e199c0c65db5",
 				Name:   "test-snapshot-name",
-				Status: models.StatusType("OK"),
+				Status: models.StatusType("stable"),
 			},
 			verify: func(t *testing.T, err error) {
 				assert.Nil(t, err)
@@ -111,6 +111,8 @@ func TestOrderSnapshot(t *testing.T) {
 			assert.NotNil(t, volumeService)
 			uc.VolumeServiceReturns(volumeService)
 
+			snapshotService.GetSnapshotByIDReturns(testcase.baseSnapshot, nil)
+
 			if testcase.expectedErr != "" {
 				snapshotService.CreateSnapshotReturns(testcase.baseSnapshot, errors.New(testcase.expectedReasonCode))
 				volumeService.GetVolumeReturns(testcase.baseVolume, errors.New(testcase.expectedReasonCode))
@@ -162,7 +164,7 @@ func TestOrderSnapshotTwo(t *testing.T) {
 	baseSnapshot = &models.Snapshot{
 		ID:        "16f293bf-test-4bff-816f-e199c0c65db5",
 		Name:      "test-snapshot-name",
-		Status:    models.StatusType("OK"),
+		Status:    models.StatusType("stable"),
 		CreatedAt: &timeNow,
 	}
 	vpcs, uc, sc, err := GetTestOpenSession(t, logger)
@@ -185,7 +187,11 @@ func TestOrderSnapshotTwo(t *testing.T) {
 	assert.NotNil(t, err)
 
 	snapshotService.CreateSnapshotReturns(baseSnapshot, nil)
+	snapshotService.GetSnapshotByIDReturns(baseSnapshot, nil)
 	volumeService.GetVolumeReturns(baseVolume, nil)
+	providerVolume.Attributes = map[string]string{models.SnapshotNameAttribute: "test-snapshot-name"}
 	err = vpcs.OrderSnapshot(providerVolume)
 	assert.Nil(t, err)
+	_, snapshotTemplate, _ := snapshotService.CreateSnapshotArgsForCall(snapshotService.CreateSnapshotCallCount() - 1)
+	assert.Equal(t, "test-snapshot-name", snapshotTemplate.Name)
 }
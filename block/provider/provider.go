@@ -24,6 +24,7 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/IBM/ibmcloud-volume-interface/config"
@@ -36,6 +37,7 @@ import (
 	vpcauth "github.com/IBM/ibmcloud-volume-vpc/common/auth"
 	"github.com/IBM/ibmcloud-volume-vpc/common/messages"
 	userError "github.com/IBM/ibmcloud-volume-vpc/common/messages"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/resourcemanager"
 	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/riaas"
 	"go.uber.org/zap"
 )
@@ -65,6 +67,16 @@ type VPCBlockProvider struct {
 	ClientProvider riaas.RegionalAPIClientProvider
 	httpClient     *http.Client
 	APIConfig      riaas.Config
+
+	// resourceGroup is the ID of the configured resource group name. The name is resolved in the account of the
+	// provider credentials by the first session which needs it
+	resourceGroup *resolvedResourceGroup
+}
+
+// resolvedResourceGroup is the resource group ID resolved by the provider, it is shared by the copies of the provider
+type resolvedResourceGroup struct {
+	mutex sync.Mutex
+	id    string
 }
 
 var _ local.Provider = &VPCBlockProvider{}
@@ -77,9 +89,21 @@ func NewProvider(conf *vpcconfig.VPCBlockConfig, logger *zap.Logger) (local.Prov
 		return nil, errors.New("incomplete config for VPCBlockProvider")
 	}
 
+	switch conf.OrphanedVolumePolicy {
+	case vpcconfig.OrphanedVolumeKeep, vpcconfig.OrphanedVolumeDelete, vpcconfig.OrphanedVolumeTag:
+	default:
+		return nil, errors.New("invalid OrphanedVolumePolicy '" + conf.OrphanedVolumePolicy + "' for VPCBlockProvider, must be one of '', 'delete' or 'tag'")
+	}
+
+	// The trusted profile is authenticated with the compute resource token instead of the API key
+	if (conf.TrustedProfileID == "") != (conf.ComputeResourceTokenFile == "") {
+		return nil, errors.New("incomplete config for VPCBlockProvider, both TrustedProfileID and ComputeResourceTokenFile are required for compute resource token authentication")
+	}
+	computeResourceAuth := conf.IsComputeResourceAuth()
+
 	//Do config validation and enable only one generationType (i.e VPC-Classic | VPC-NG)
-	gcConfigFound := (conf.VPCConfig.EndpointURL != "" || conf.VPCConfig.PrivateEndpointURL != "") && (conf.VPCConfig.TokenExchangeURL != "" || conf.VPCConfig.IKSTokenExchangePrivateURL != "") && (conf.VPCConfig.APIKey != "") && (conf.VPCConfig.ResourceGroupID != "")
-	g2ConfigFound := (conf.VPCConfig.G2EndpointPrivateURL != "" || conf.VPCConfig.G2EndpointURL != "") && (conf.VPCConfig.IKSTokenExchangePrivateURL != "" || conf.VPCConfig.G2TokenExchangeURL != "") && (conf.VPCConfig.G2APIKey != "") && (conf.VPCConfig.G2ResourceGroupID != "")
+	gcConfigFound := (conf.VPCConfig.EndpointURL != "" || conf.VPCConfig.PrivateEndpointURL != "") && (conf.VPCConfig.TokenExchangeURL != "" || conf.VPCConfig.IKSTokenExchangePrivateURL != "") && (conf.VPCConfig.APIKey != "" || computeResourceAuth) && (conf.VPCConfig.ResourceGroupID != "" || conf.ResourceGroupName != "")
+	g2ConfigFound := (conf.VPCConfig.G2EndpointPrivateURL != "" || conf.VPCConfig.G2EndpointURL != "") && (conf.VPCConfig.IKSTokenExchangePrivateURL != "" || conf.VPCConfig.G2TokenExchangeURL != "") && (conf.VPCConfig.G2APIKey != "" || computeResourceAuth) && (conf.VPCConfig.G2ResourceGroupID != "" || conf.G2ResourceGroupName != "")
 	//if both config found, look for VPCTypeEnabled, otherwise default to GC
 	//Incase of NG configurations, override the base properties.
 	if (gcConfigFound && g2ConfigFound && conf.VPCConfig.VPCTypeEnabled == VPCNextGen) || (!gcConfigFound && g2ConfigFound) {
@@ -95,6 +119,7 @@ func NewProvider(conf *vpcconfig.VPCBlockConfig, logger *zap.Logger) (local.Prov
 
 		conf.VPCConfig.APIKey = conf.VPCConfig.G2APIKey
 		conf.VPCConfig.ResourceGroupID = conf.VPCConfig.G2ResourceGroupID
+		conf.ResourceGroupName = conf.G2ResourceGroupName
 
 		//Set API Generation As 2 (if unspecified in config/ENV-VAR)
 		if conf.VPCConfig.G2VPCAPIGeneration <= 0 {
@@ -124,6 +149,11 @@ func NewProvider(conf *vpcconfig.VPCBlockConfig, logger *zap.Logger) (local.Prov
 		}
 	}
 
+	// The compute resource token is exchanged with IAM directly, not through the IKS private route
+	if computeResourceAuth && conf.VPCConfig.TokenExchangeURL == "" {
+		return nil, errors.New("incomplete config for VPCBlockProvider, the IAM token exchange URL is required for compute resource token authentication")
+	}
+
 	contextCF, err := vpcauth.NewVPCContextCredentialsFactory(conf)
 	if err != nil {
 		return nil, err
@@ -144,20 +174,21 @@ func NewProvider(conf *vpcconfig.VPCBlockConfig, logger *zap.Logger) (local.Prov
 		return nil, err
 	}
 
-	// SetRetryParameters sets the retry logic parameters
-	SetRetryParameters(conf.VPCConfig.MaxRetryAttempt, conf.VPCConfig.MaxRetryGap)
 	provider := &VPCBlockProvider{
 		timeout:        timeout,
 		Config:         conf,
-		tokenGenerator: &tokenGenerator{config: conf.VPCConfig},
+		tokenGenerator: newTokenGenerator(conf),
 		ContextCF:      contextCF,
 		httpClient:     httpClient,
+		resourceGroup:  &resolvedResourceGroup{},
 		APIConfig: riaas.Config{
 			BaseURL:       conf.VPCConfig.EndpointURL,
 			HTTPClient:    httpClient,
 			APIVersion:    conf.VPCConfig.APIVersion,
 			APIGeneration: conf.VPCConfig.VPCAPIGeneration,
 			ResourceGroup: conf.VPCConfig.ResourceGroupID,
+			// The resource groups are resolved once for all the sessions
+			ResourceGroupCache: resourcemanager.NewResourceGroupCache(),
 		},
 	}
 	// Update VPC config for IKS deployment
@@ -180,6 +211,10 @@ func (vpcp *VPCBlockProvider) OpenSession(ctx context.Context, contextCredential
 		ctxLogger.Debug("Exiting OpenSession")
 	}()
 
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
 	// validate that we have what we need - i.e. valid credentials
 	if contextCredentials.Credential == "" {
 		return nil, util.NewError("Error Insufficient Authentication", "No authentication credential provided")
@@ -194,12 +229,25 @@ func (vpcp *VPCBlockProvider) OpenSession(ctx context.Context, contextCredential
 	}
 	ctxLogger.Debug("", zap.Reflect("apiConfig.BaseURL", vpcp.APIConfig.BaseURL))
 
-	if ctx != nil && ctx.Value(provider.RequestID) != nil {
+	// The provider config is shared by all sessions, the requests of the session are sent with the context of each operation
+	apiConfig := vpcp.APIConfig
+	if ctx.Value(provider.RequestID) != nil {
 		// set ContextID only of speicifed in the context
-		vpcp.APIConfig.ContextID = fmt.Sprintf("%v", ctx.Value(provider.RequestID))
-		ctxLogger.Info("", zap.Reflect("apiConfig.ContextID", vpcp.APIConfig.ContextID))
+		apiConfig.ContextID = fmt.Sprintf("%v", ctx.Value(provider.RequestID))
+		ctxLogger.Info("", zap.Reflect("apiConfig.ContextID", apiConfig.ContextID))
 	}
-	client, err := vpcp.ClientProvider.New(vpcp.APIConfig)
+
+	// Each session has its own retry policy as per the configured retry parameters
+	retryPolicy := SetRetryParameters(vpcp.Config.VPCConfig.MaxRetryAttempt, vpcp.Config.VPCConfig.MaxRetryGap)
+	ctxLogger.Debug("", zap.Reflect("RetryPolicy", retryPolicy))
+
+	// The configured resource group name is resolved before the client is created, as the client sends the ID
+	resourceGroupID, err := vpcp.getResourceGroupID(ctx, retryPolicy, ctxLogger)
+	if err != nil {
+		return nil, err
+	}
+	apiConfig.ResourceGroup = resourceGroupID
+	client, err := vpcp.ClientProvider.New(apiConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -211,21 +259,16 @@ func (vpcp *VPCBlockProvider) OpenSession(ctx context.Context, contextCredential
 	}
 	ctxLogger.Debug("", zap.Reflect("Token", token.Token))
 
-	err = client.Login(token.Token)
+	// The token is renewed through the context credentials factory when the API key or the compute resource token is known
+	var tokenSource *sessionTokenSource
+	if vpcp.ContextCF != nil && (vpcp.Config.VPCConfig.APIKey != "" || vpcp.Config.IsComputeResourceAuth()) {
+		tokenSource = newSessionTokenSource(token.Token, vpcp.ContextCF, vpcp.Config.VPCConfig.APIKey, ctxLogger)
+	}
+	err = login(client, tokenSource, token.Token)
 	if err != nil {
 		return nil, err
 	}
 
-	// Update retry logic default values
-	if vpcp.Config.VPCConfig.MaxRetryAttempt > 0 {
-		ctxLogger.Debug("", zap.Reflect("MaxRetryAttempt", vpcp.Config.VPCConfig.MaxRetryAttempt))
-		maxRetryAttempt = vpcp.Config.VPCConfig.MaxRetryAttempt
-	}
-	if vpcp.Config.VPCConfig.MaxRetryGap > 0 {
-		ctxLogger.Debug("", zap.Reflect("MaxRetryGap", vpcp.Config.VPCConfig.MaxRetryGap))
-		maxRetryGap = vpcp.Config.VPCConfig.MaxRetryGap
-	}
-
 	vpcSession := &VPCSession{
 		VPCAccountID:          contextCredentials.IAMAccountID,
 		Config:                vpcp.Config,
@@ -235,11 +278,58 @@ func (vpcp *VPCBlockProvider) OpenSession(ctx context.Context, contextCredential
 		Apiclient:             client,
 		APIClientVolAttachMgr: client.VolumeAttachService(),
 		Logger:                ctxLogger,
-		APIRetry:              NewFlexyRetryDefault(),
+		APIRetry:              retryPolicy,
+		Context:               ctx,
 	}
 	return vpcSession, nil
 }
 
+// getResourceGroupID returns the ID of the configured resource group. The configured resource group name is resolved
+// with the credentials of the provider once, a failed resolution is tried again by the next session
+func (vpcp *VPCBlockProvider) getResourceGroupID(ctx context.Context, retryPolicy RetryPolicy, ctxLogger *zap.Logger) (string, error) {
+	if vpcp.Config.VPCConfig.ResourceGroupID != "" || vpcp.Config.ResourceGroupName == "" {
+		return vpcp.Config.VPCConfig.ResourceGroupID, nil
+	}
+
+	vpcp.resourceGroup.mutex.Lock()
+	defer vpcp.resourceGroup.mutex.Unlock()
+	if vpcp.resourceGroup.id != "" {
+		return vpcp.resourceGroup.id, nil
+	}
+
+	if vpcp.ContextCF == nil {
+		return "", util.NewError("Error Insufficient Authentication", "No credentials to resolve the resource group "+vpcp.Config.ResourceGroupName)
+	}
+	// The resource group of the provider belongs to the account of the provider credentials, not of the session
+	providerCredentials, err := vpcp.ContextCF.ForIAMAccessToken(vpcp.Config.VPCConfig.APIKey, ctxLogger)
+	if err != nil {
+		return "", err
+	}
+	client, err := vpcp.ClientProvider.New(vpcp.APIConfig)
+	if err != nil {
+		return "", err
+	}
+	err = client.Login(providerCredentials.Credential)
+	if err != nil {
+		return "", err
+	}
+
+	resourceGroupID, err := resolveResourceGroupID(ctx, retryPolicy, client.WithContext(ctx).ResourceGroupService(), vpcp.Config.ResourceGroupName, providerCredentials.IAMAccountID, ctxLogger)
+	if err != nil {
+		return "", err
+	}
+	vpcp.resourceGroup.id = resourceGroupID
+	return resourceGroupID, nil
+}
+
+// login authenticates the client with the token source if any, else with the token
+func login(client riaas.RegionalAPI, tokenSource *sessionTokenSource, token string) error {
+	if tokenSource != nil {
+		return client.LoginWithTokenSource(tokenSource)
+	}
+	return client.Login(token)
+}
+
 // getAccessToken ...
 func getAccessToken(creds provider.ContextCredentials, logger *zap.Logger) (token *iam.AccessToken, err error) {
 	switch creds.AuthType {
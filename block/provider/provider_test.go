@@ -20,6 +20,7 @@ package provider
 import (
 	"bytes"
 	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -31,6 +32,8 @@ import (
 	"github.com/IBM/ibmcloud-volume-interface/provider/auth"
 	"github.com/IBM/ibmcloud-volume-interface/provider/local"
 	vpcconfig "github.com/IBM/ibmcloud-volume-vpc/block/vpcconfig"
+	instanceFakes "github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/instances/fakes"
+	regionFakes "github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/regions/fakes"
 	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/riaas/fakes"
 	volumeServiceFakes "github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/vpcvolume/fakes"
 	"github.com/stretchr/testify/assert"
@@ -71,7 +74,7 @@ func GetTestLogger(t *testing.T) (logger *zap.Logger, teardown func()) {
 	logger = zap.New(
 		zapcore.NewCore(
 			zapcore.NewJSONEncoder(encoderCfg),
-			zapcore.AddSync(buf),
+			zapcore.Lock(zapcore.AddSync(buf)),
 			atom,
 		),
 		zap.AddCaller(),
@@ -207,15 +210,94 @@ func TestNewProvider(t *testing.T) {
 	zone := "Test Zone"
 	contextCF, _ := prov.ContextCredentialsFactory(&zone)
 	assert.NotNil(t, contextCF)
+
+	// invalid orphaned volume policy
+	conf = &vpcconfig.VPCBlockConfig{
+		VPCConfig: &config.VPCProviderConfig{
+			Enabled:          true,
+			EndpointURL:      TestEndpointURL,
+			TokenExchangeURL: IamURL,
+			APIKey:           IamClientSecret,
+		},
+		OrphanedVolumePolicy: "remove",
+	}
+
+	prov, err = NewProvider(conf, logger)
+	assert.Nil(t, prov)
+	assert.NotNil(t, err)
+
+	// gc compute resource token test
+	conf = &vpcconfig.VPCBlockConfig{
+		VPCConfig: &config.VPCProviderConfig{
+			Enabled:          true,
+			EndpointURL:      TestEndpointURL,
+			TokenExchangeURL: IamURL,
+			ResourceGroupID:  "test-resource-group",
+		},
+		TrustedProfileID:         "test-profile-id",
+		ComputeResourceTokenFile: "/var/run/secrets/tokens/sa-token",
+	}
+
+	prov, err = NewProvider(conf, logger)
+	assert.NotNil(t, prov)
+	assert.Nil(t, err)
+	assert.Equal(t, VPCClassic, conf.VPCConfig.VPCBlockProviderType)
+
+	// gen2 compute resource token test
+	conf = &vpcconfig.VPCBlockConfig{
+		VPCConfig: &config.VPCProviderConfig{
+			Enabled:            true,
+			G2EndpointURL:      TestEndpointURL,
+			G2TokenExchangeURL: IamURL,
+			G2ResourceGroupID:  "test-resource-group",
+		},
+		TrustedProfileID:         "test-profile-id",
+		ComputeResourceTokenFile: "/var/run/secrets/tokens/sa-token",
+	}
+
+	prov, err = NewProvider(conf, logger)
+	assert.NotNil(t, prov)
+	assert.Nil(t, err)
+	assert.Equal(t, VPCNextGen, conf.VPCConfig.VPCBlockProviderType)
+	assert.Equal(t, IamURL, conf.VPCConfig.TokenExchangeURL)
+
+	// compute resource token without trusted profile
+	conf = &vpcconfig.VPCBlockConfig{
+		VPCConfig: &config.VPCProviderConfig{
+			Enabled:          true,
+			EndpointURL:      TestEndpointURL,
+			TokenExchangeURL: IamURL,
+		},
+		ComputeResourceTokenFile: "/var/run/secrets/tokens/sa-token",
+	}
+
+	prov, err = NewProvider(conf, logger)
+	assert.Nil(t, prov)
+	assert.NotNil(t, err)
+
+	// compute resource token without IAM token exchange URL
+	conf = &vpcconfig.VPCBlockConfig{
+		APIConfig: &config.APIConfig{
+			PassthroughSecret: CsrfToken,
+		},
+		VPCConfig: &config.VPCProviderConfig{
+			Enabled:                    true,
+			PrivateEndpointURL:         PrivateRIaaSEndpoint,
+			IKSTokenExchangePrivateURL: PrivateContainerAPIURL,
+		},
+		TrustedProfileID:         "test-profile-id",
+		ComputeResourceTokenFile: "/var/run/secrets/tokens/sa-token",
+	}
+
+	prov, err = NewProvider(conf, logger)
+	assert.Nil(t, prov)
+	assert.NotNil(t, err)
 }
 
 func GetTestProvider(t *testing.T, logger *zap.Logger) (*VPCBlockProvider, error) {
 	var cp *fakes.RegionalAPIClientProvider
 	var uc, sc *fakes.RegionalAPI
 
-	// SetRetryParameters sets the retry logic parameters
-	SetRetryParameters(2, 5)
-
 	logger.Info("Getting New test Provider")
 	conf := &vpcconfig.VPCBlockConfig{
 		APIConfig: &config.APIConfig{
@@ -249,6 +331,14 @@ func GetTestProvider(t *testing.T, logger *zap.Logger) (*VPCBlockProvider, error
 	// Inject a fake RIAAS API client
 	cp = &fakes.RegionalAPIClientProvider{}
 	uc = &fakes.RegionalAPI{}
+	// No profile details by default, so the validation against the volume profile is skipped
+	uc.ProfileServiceReturns(&volumeServiceFakes.ProfileService{})
+	// No zones by default, so the zone validation is skipped
+	uc.RegionServiceReturns(&regionFakes.RegionService{})
+	// No instance details by default, so the attach target validation is skipped
+	uc.InstanceServiceReturns(&instanceFakes.InstanceService{})
+	// The requests of each operation are sent through the same fake whatever the context
+	uc.WithContextReturns(uc)
 	cp.NewReturnsOnCall(0, uc, nil)
 	sc = &fakes.RegionalAPI{}
 	cp.NewReturnsOnCall(1, sc, nil)
@@ -268,6 +358,7 @@ func GetTestProvider(t *testing.T, logger *zap.Logger) (*VPCBlockProvider, error
 		Config:         conf,
 		tokenGenerator: &tokenGenerator{config: conf.VPCConfig},
 		httpClient:     httpClient,
+		resourceGroup:  &resolvedResourceGroup{},
 	}
 	assert.NotNil(t, provider)
 	assert.Equal(t, provider.timeout, timeout)
@@ -323,6 +414,48 @@ func TestOpenSession(t *testing.T) {
 	assert.Nil(t, sessn)
 }
 
+func TestOpenSessionWithContext(t *testing.T) {
+	logger, teardown := GetTestLogger(t)
+	defer teardown()
+
+	vpcp, _ := GetTestProvider(t, logger)
+	cp := &fakes.RegionalAPIClientProvider{}
+	cp.NewReturns(&fakes.RegionalAPI{}, nil)
+	vpcp.ClientProvider = cp
+	contextCredentials := provider.ContextCredentials{
+		AuthType:     provider.IAMAccessToken,
+		Credential:   TestProviderAccessToken,
+		IAMAccountID: TestIKSAccountID,
+	}
+
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), provider.RequestID, "request-1"))
+	defer cancel()
+	sessn, err := vpcp.OpenSession(ctx, contextCredentials, logger)
+	require.NoError(t, err)
+	vpcs, _ := sessn.(*VPCSession)
+	require.NotNil(t, vpcs)
+	// The session is bound to the context, the client is not as the requests are sent with the context of
+	// each operation, the provider config is left untouched
+	assert.Equal(t, ctx, vpcs.GetContext())
+	assert.Nil(t, cp.NewArgsForCall(0).Context)
+	assert.Equal(t, "request-1", cp.NewArgsForCall(0).ContextID)
+	assert.Nil(t, vpcp.APIConfig.Context)
+	assert.Equal(t, "", vpcp.APIConfig.ContextID)
+
+	// The retries of the session stop once the context is cancelled
+	cancel()
+	err = vpcs.APIRetry.Retry(vpcs.GetContext(), logger, func() error {
+		return errors.New("backend error")
+	})
+	assert.Equal(t, context.Canceled, err)
+
+	// A session opened without context uses the background context
+	sessn, err = vpcp.OpenSession(nil, contextCredentials, logger) //nolint:staticcheck
+	require.NoError(t, err)
+	vpcs, _ = sessn.(*VPCSession)
+	assert.Equal(t, context.Background(), vpcs.GetContext())
+}
+
 func GetTestOpenSession(t *testing.T, logger *zap.Logger) (sessn *VPCSession, uc, sc *fakes.RegionalAPI, err error) {
 	vpcp, err := GetTestProvider(t, logger)
 
@@ -335,6 +468,14 @@ func GetTestOpenSession(t *testing.T, logger *zap.Logger) (sessn *VPCSession, uc
 	// Inject a fake RIAAS API client
 	cp := &fakes.RegionalAPIClientProvider{}
 	uc = &fakes.RegionalAPI{}
+	// No profile details by default, so the validation against the volume profile is skipped
+	uc.ProfileServiceReturns(&volumeServiceFakes.ProfileService{})
+	// No zones by default, so the zone validation is skipped
+	uc.RegionServiceReturns(&regionFakes.RegionService{})
+	// No instance details by default, so the attach target validation is skipped
+	uc.InstanceServiceReturns(&instanceFakes.InstanceService{})
+	// The requests of each operation are sent through the same fake whatever the context
+	uc.WithContextReturns(uc)
 	cp.NewReturnsOnCall(0, uc, nil)
 	sc = &fakes.RegionalAPI{}
 	cp.NewReturnsOnCall(1, sc, nil)
@@ -352,7 +493,14 @@ func GetTestOpenSession(t *testing.T, logger *zap.Logger) (sessn *VPCSession, uc
 		Provider:   VPC,
 		Apiclient:  uc,
 		Logger:     logger,
-		APIRetry:   NewFlexyRetryDefault(),
+		// The configured attempts are kept, the waits are shortened so that the retries do not slow the tests down
+		APIRetry: RetryPolicy{
+			InitialGap:  time.Millisecond,
+			Multiplier:  defaultRetryMultiplier,
+			MaxGap:      time.Millisecond,
+			MaxAttempts: vpcp.Config.VPCConfig.MaxRetryAttempt,
+			Jitter:      defaultRetryJitter,
+		},
 	}
 
 	return
@@ -0,0 +1,48 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package provider ...
+package provider
+
+import (
+	"context"
+
+	userError "github.com/IBM/ibmcloud-volume-vpc/common/messages"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/resourcemanager"
+	"go.uber.org/zap"
+)
+
+// resolveResourceGroupID gets the ID of the resource group with the given name, as resource group names are not
+// supported by RIaaS. The resource groups are cached by the resource group service
+func resolveResourceGroupID(ctx context.Context, retryPolicy RetryPolicy, resourceGroupManager resourcemanager.ResourceGroupManager, name string, accountID string, logger *zap.Logger) (string, error) {
+	logger.Info("Resolving the resource group ID", zap.Reflect("ResourceGroupName", name), zap.Reflect("AccountID", accountID))
+
+	var resourceGroup *models.ResourceGroup
+	var err error
+	err = retryPolicy.Retry(ctx, logger, func() error {
+		resourceGroup, err = resourceGroupManager.GetResourceGroupByName(name, accountID, logger)
+		return err
+	})
+	if err != nil {
+		return "", userError.GetUserError("ResourceGroupLookupFailed", err, name)
+	}
+	if resourceGroup == nil || len(resourceGroup.ID) == 0 {
+		return "", userError.GetUserError("ResourceGroupNotFound", nil, name)
+	}
+
+	logger.Info("Resolved the resource group ID", zap.Reflect("ResourceGroup", resourceGroup))
+	return resourceGroup.ID, nil
+}
@@ -0,0 +1,315 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package provider ...
+package provider
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/IBM/ibmcloud-volume-interface/config"
+	"github.com/IBM/ibmcloud-volume-interface/lib/provider"
+	vpcconfig "github.com/IBM/ibmcloud-volume-vpc/block/vpcconfig"
+	userError "github.com/IBM/ibmcloud-volume-vpc/common/messages"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
+	resourceManagerFakes "github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/resourcemanager/fakes"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/riaas/fakes"
+	volumeServiceFakes "github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/vpcvolume/fakes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveResourceGroupID(t *testing.T) {
+	//var err error
+	logger, teardown := GetTestLogger(t)
+	defer teardown()
+	userError.MessagesEn = userError.InitMessages()
+
+	testCases := []struct {
+		testCaseName     string
+		resourceGroup    *models.ResourceGroup
+		resourceGroupErr error
+
+		expectedID  string
+		expectedErr string
+	}{
+		{
+			testCaseName:  "Resource group found",
+			resourceGroup: &models.ResourceGroup{ID: "rg-id", Name: "test-group"},
+			expectedID:    "rg-id",
+		}, {
+			testCaseName: "Resource group not found",
+			expectedErr:  "ResourceGroupNotFound",
+		}, {
+			testCaseName:     "Resource group lookup failed",
+			resourceGroupErr: &models.Error{Errors: []models.ErrorItem{{Code: models.ErrorCodeNotFound, Message: "not found"}}},
+			expectedErr:      "ResourceGroupLookupFailed",
+		},
+	}
+
+	for _, testcase := range testCases {
+		t.Run(testcase.testCaseName, func(t *testing.T) {
+			resourceGroupService := &resourceManagerFakes.ResourceGroupService{}
+			resourceGroupService.GetResourceGroupByNameReturns(testcase.resourceGroup, testcase.resourceGroupErr)
+
+			resourceGroupID, err := resolveResourceGroupID(context.Background(), NewFlexyRetry(2, 5), resourceGroupService, "test-group", "test-account", logger)
+			if testcase.expectedErr != "" {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), testcase.expectedErr)
+				}
+			} else {
+				assert.Nil(t, err)
+			}
+			assert.Equal(t, testcase.expectedID, resourceGroupID)
+			if assert.Equal(t, 1, resourceGroupService.GetResourceGroupByNameCallCount()) {
+				name, accountID, _ := resourceGroupService.GetResourceGroupByNameArgsForCall(0)
+				assert.Equal(t, "test-group", name)
+				assert.Equal(t, "test-account", accountID)
+			}
+		})
+	}
+}
+
+func TestCreateVolumeWithResourceGroupName(t *testing.T) {
+	//var err error
+	logger, teardown := GetTestLogger(t)
+	defer teardown()
+
+	vpcs, uc, sc, err := GetTestOpenSession(t, logger)
+	assert.NotNil(t, vpcs)
+	assert.NotNil(t, uc)
+	assert.NotNil(t, sc)
+	assert.Nil(t, err)
+
+	resourceGroupService := &resourceManagerFakes.ResourceGroupService{}
+	uc.ResourceGroupServiceReturns(resourceGroupService)
+	resourceGroupService.GetResourceGroupByNameReturns(&models.ResourceGroup{ID: "rg-id", Name: "test-group"}, nil)
+	volumeService := &volumeServiceFakes.VolumeService{}
+	uc.VolumeServiceReturns(volumeService)
+	// The existing volume only matches the request if the resource group name was resolved to the ID
+	existingVolume := &models.Volume{
+		ID:            "16f293bf-test-4bff-816f-e199c0c65db5",
+		Name:          "test-volume-name",
+		Status:        models.StatusType("available"),
+		Capacity:      int64(10),
+		Zone:          &models.Zone{Name: "test-zone"},
+		Profile:       &models.Profile{Name: "general-purpose"},
+		ResourceGroup: &models.ResourceGroup{ID: "rg-id"},
+	}
+	volumeService.GetVolumeByNameReturns(existingVolume, nil)
+	volumeService.GetVolumeReturns(existingVolume, nil)
+
+	volume, err := vpcs.CreateVolume(provider.Volume{
+		Name:     String("test-volume-name"),
+		Capacity: Int(10),
+		Az:       "test-zone",
+		VPCVolume: provider.VPCVolume{
+			Profile:       &provider.Profile{Name: "general-purpose"},
+			ResourceGroup: &provider.ResourceGroup{Name: "test-group"},
+		},
+	})
+	assert.Nil(t, err)
+	if assert.NotNil(t, volume) {
+		assert.Equal(t, "16f293bf-test-4bff-816f-e199c0c65db5", volume.VolumeID)
+	}
+	assert.Equal(t, 1, resourceGroupService.GetResourceGroupByNameCallCount())
+	assert.Equal(t, 0, volumeService.CreateVolumeCallCount())
+
+	// A resource group which can not be resolved is rejected before ordering
+	resourceGroupService.GetResourceGroupByNameReturns(nil, nil)
+	volume, err = vpcs.CreateVolume(provider.Volume{
+		Name:     String("test-volume-name"),
+		Capacity: Int(10),
+		Az:       "test-zone",
+		VPCVolume: provider.VPCVolume{
+			Profile:       &provider.Profile{Name: "general-purpose"},
+			ResourceGroup: &provider.ResourceGroup{Name: "unknown-group"},
+		},
+	})
+	assert.Nil(t, volume)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "ResourceGroupNotFound")
+	}
+	assert.Equal(t, 1, volumeService.GetVolumeByNameCallCount())
+}
+
+func TestListVolumesWithResourceGroupName(t *testing.T) {
+	//var err error
+	logger, teardown := GetTestLogger(t)
+	defer teardown()
+
+	vpcs, uc, sc, err := GetTestOpenSession(t, logger)
+	assert.NotNil(t, vpcs)
+	assert.NotNil(t, uc)
+	assert.NotNil(t, sc)
+	assert.Nil(t, err)
+
+	resourceGroupService := &resourceManagerFakes.ResourceGroupService{}
+	uc.ResourceGroupServiceReturns(resourceGroupService)
+	resourceGroupService.GetResourceGroupByNameReturns(&models.ResourceGroup{ID: "rg-id", Name: "test-group"}, nil)
+	volumeService := &volumeServiceFakes.VolumeService{}
+	uc.VolumeServiceReturns(volumeService)
+	volumeService.ListVolumesReturns(&models.VolumeList{}, nil)
+
+	_, err = vpcs.ListVolumes(10, "", map[string]string{"resource_group.name": "test-group"})
+	assert.Nil(t, err)
+	if assert.Equal(t, 1, volumeService.ListVolumesCallCount()) {
+		_, _, filters, _ := volumeService.ListVolumesArgsForCall(0)
+		assert.Equal(t, "rg-id", filters.ResourceGroupID)
+	}
+
+	// The resource group ID takes precedence over the name
+	_, err = vpcs.ListVolumes(10, "", map[string]string{"resource_group.id": "other-rg-id", "resource_group.name": "test-group"})
+	assert.Nil(t, err)
+	if assert.Equal(t, 2, volumeService.ListVolumesCallCount()) {
+		_, _, filters, _ := volumeService.ListVolumesArgsForCall(1)
+		assert.Equal(t, "other-rg-id", filters.ResourceGroupID)
+	}
+	assert.Equal(t, 1, resourceGroupService.GetResourceGroupByNameCallCount())
+
+	resourceGroupService.GetResourceGroupByNameReturns(nil, nil)
+	_, err = vpcs.ListVolumes(10, "", map[string]string{"resource_group.name": "unknown-group"})
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "ResourceGroupNotFound")
+	}
+}
+
+func TestNewProviderWithResourceGroupName(t *testing.T) {
+	//var err error
+	logger, teardown := GetTestLogger(t)
+	defer teardown()
+
+	// gen2 config is only complete with the resource group ID or name
+	conf := &vpcconfig.VPCBlockConfig{
+		VPCConfig: &config.VPCProviderConfig{
+			Enabled:            true,
+			G2EndpointURL:      TestEndpointURL,
+			G2TokenExchangeURL: IamURL,
+			G2APIKey:           IamClientSecret,
+		},
+		G2ResourceGroupName: "test-group",
+	}
+
+	prov, err := NewProvider(conf, logger)
+	assert.NotNil(t, prov)
+	assert.Nil(t, err)
+	assert.Equal(t, VPCNextGen, conf.VPCConfig.VPCBlockProviderType)
+	assert.Equal(t, "test-group", conf.ResourceGroupName)
+}
+
+func TestOpenSessionWithResourceGroupName(t *testing.T) {
+	//var err error
+	logger, teardown := GetTestLogger(t)
+	defer teardown()
+
+	vpcp, err := GetTestProvider(t, logger)
+	require.NoError(t, err)
+	vpcp.Config.ResourceGroupName = "test-group"
+	vpcp.Config.VPCConfig.ResourceGroupID = ""
+
+	resourceGroupService := &resourceManagerFakes.ResourceGroupService{}
+	uc := &fakes.RegionalAPI{}
+	uc.WithContextReturns(uc)
+	uc.ResourceGroupServiceReturns(resourceGroupService)
+	cp := &fakes.RegionalAPIClientProvider{}
+	cp.NewReturns(uc, nil)
+	vpcp.ClientProvider = cp
+	contextCredentials := provider.ContextCredentials{
+		AuthType:     provider.IAMAccessToken,
+		Credential:   TestProviderAccessToken,
+		IAMAccountID: TestIKSAccountID,
+	}
+
+	// The resource group is resolved with the credentials of the provider
+	ccf := &testContextCredentialsFactory{tokens: []string{"provider-token-1", "provider-token-2"}, accountID: "provider-account"}
+	vpcp.ContextCF = ccf
+
+	// A resource group which can not be resolved fails the session
+	sessn, err := vpcp.OpenSession(context.Background(), contextCredentials, logger)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ResourceGroupNotFound")
+	assert.Nil(t, sessn)
+	assert.Equal(t, 1, cp.NewCallCount())
+	assert.Equal(t, "provider-token-1", uc.LoginArgsForCall(0))
+
+	// The resolution is tried again by the next session
+	resourceGroupService.GetResourceGroupByNameReturns(&models.ResourceGroup{ID: "rg-id", Name: "test-group"}, nil)
+	sessn, err = vpcp.OpenSession(context.Background(), contextCredentials, logger)
+	require.NoError(t, err)
+	assert.NotNil(t, sessn)
+	// The client of the session is created once, with the resource group ID
+	if assert.Equal(t, 3, cp.NewCallCount()) {
+		assert.Equal(t, "", cp.NewArgsForCall(1).ResourceGroup)
+		assert.Equal(t, "rg-id", cp.NewArgsForCall(2).ResourceGroup)
+	}
+	name, accountID, _ := resourceGroupService.GetResourceGroupByNameArgsForCall(1)
+	assert.Equal(t, "test-group", name)
+	assert.Equal(t, "provider-account", accountID)
+
+	// The resolved ID is used by the sessions opened afterwards, the shared config is left untouched
+	sessn, err = vpcp.OpenSession(context.Background(), contextCredentials, logger)
+	require.NoError(t, err)
+	assert.NotNil(t, sessn)
+	assert.Equal(t, 2, resourceGroupService.GetResourceGroupByNameCallCount())
+	assert.Equal(t, 2, ccf.calls)
+	if assert.Equal(t, 4, cp.NewCallCount()) {
+		assert.Equal(t, "rg-id", cp.NewArgsForCall(3).ResourceGroup)
+	}
+	assert.Equal(t, "", vpcp.Config.VPCConfig.ResourceGroupID)
+	assert.Equal(t, "", vpcp.APIConfig.ResourceGroup)
+}
+
+func TestOpenSessionWithResourceGroupNameConcurrently(t *testing.T) {
+	logger, teardown := GetTestLogger(t)
+	defer teardown()
+
+	vpcp, err := GetTestProvider(t, logger)
+	require.NoError(t, err)
+	vpcp.Config.ResourceGroupName = "test-group"
+	vpcp.Config.VPCConfig.ResourceGroupID = ""
+	vpcp.ContextCF = &testContextCredentialsFactory{tokens: []string{"provider-token"}, accountID: "provider-account"}
+
+	resourceGroupService := &resourceManagerFakes.ResourceGroupService{}
+	resourceGroupService.GetResourceGroupByNameReturns(&models.ResourceGroup{ID: "rg-id", Name: "test-group"}, nil)
+	uc := &fakes.RegionalAPI{}
+	uc.WithContextReturns(uc)
+	uc.ResourceGroupServiceReturns(resourceGroupService)
+	cp := &fakes.RegionalAPIClientProvider{}
+	cp.NewReturns(uc, nil)
+	vpcp.ClientProvider = cp
+	contextCredentials := provider.ContextCredentials{
+		AuthType:     provider.IAMAccessToken,
+		Credential:   TestProviderAccessToken,
+		IAMAccountID: TestIKSAccountID,
+	}
+
+	// The resource group is resolved once for the sessions opened at the same time
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sessn, err := vpcp.OpenSession(context.Background(), contextCredentials, logger)
+			assert.NoError(t, err)
+			assert.NotNil(t, sessn)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 1, resourceGroupService.GetResourceGroupByNameCallCount())
+	assert.Equal(t, 6, cp.NewCallCount())
+}
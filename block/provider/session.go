@@ -18,6 +18,8 @@
 package provider
 
 import (
+	"context"
+
 	"github.com/IBM/ibmcloud-volume-interface/lib/provider"
 	vpcconfig "github.com/IBM/ibmcloud-volume-vpc/block/vpcconfig"
 	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/instances"
@@ -36,7 +38,9 @@ type VPCSession struct {
 	APIClientVolAttachMgr instances.VolumeAttachManager
 	APIVersion            string
 	Logger                *zap.Logger
-	APIRetry              FlexyRetry
+	APIRetry              RetryPolicy
+	// Context of the session, the retries and waits of the session operations stop when it is done
+	Context context.Context
 }
 
 const (
@@ -53,6 +57,14 @@ var (
 	DeleteVolumeReason = "deleted by ibm-volume-lib on behalf of user request"
 )
 
+// GetContext returns the context of the session, the background context if the session has none
+func (vpcs *VPCSession) GetContext() context.Context {
+	if vpcs.Context == nil {
+		return context.Background()
+	}
+	return vpcs.Context
+}
+
 // Close at present does nothing
 func (*VPCSession) Close() {
 	// Do nothing for now
@@ -20,8 +20,11 @@ package provider
 import (
 	"crypto/rsa"
 	"errors"
+	"fmt"
 	"io/ioutil"
+	"os"
 	"path/filepath"
+	"sync"
 	"time"
 
 	"github.com/dgrijalva/jwt-go"
@@ -31,47 +34,152 @@ import (
 	"github.com/IBM/ibmcloud-volume-interface/lib/provider"
 	"github.com/IBM/ibmcloud-volume-interface/provider/auth"
 	"github.com/IBM/ibmcloud-volume-interface/provider/local"
+	vpcconfig "github.com/IBM/ibmcloud-volume-vpc/block/vpcconfig"
+)
+
+const (
+	// defaultTokenTTL is the validity of the service tokens if not configured
+	defaultTokenTTL = 10 * time.Minute
+	// defaultTokenNotBeforeSkew is the allowed clock skew of the services which accept the tokens, if not configured
+	defaultTokenNotBeforeSkew = 1 * time.Minute
 )
 
 // tokenGenerator ...
 type tokenGenerator struct {
 	config *config.VPCProviderConfig
 
-	tokenKID        string
+	keyDir          string
+	tokenKID        string   // KID of the active signer
+	tokenKIDs       []string // KIDs of the other keys in rotation
 	tokenTTL        time.Duration
 	tokenBeforeTime time.Duration
 
-	privateKey *rsa.PrivateKey // Secret. Do not export
+	mutex sync.Mutex
+	keys  map[string]*tokenKey // Secret. Do not export
+}
+
+// tokenKey is a private key of the key directory along with the state of its file when it was read
+type tokenKey struct {
+	privateKey *rsa.PrivateKey
+	modTime    time.Time
+	size       int64
+}
+
+// newTokenGenerator returns the token generator as per the key directory, KIDs and validity of the config
+func newTokenGenerator(conf *vpcconfig.VPCBlockConfig) *tokenGenerator {
+	tg := &tokenGenerator{
+		config:          conf.VPCConfig,
+		keyDir:          conf.TokenKeyDir,
+		tokenKID:        conf.TokenKID,
+		tokenTTL:        conf.TokenTTL,
+		tokenBeforeTime: -conf.TokenNotBeforeSkew,
+	}
+	for _, kid := range conf.TokenKIDs {
+		if kid != conf.TokenKID {
+			tg.tokenKIDs = append(tg.tokenKIDs, kid)
+		}
+	}
+	if tg.tokenTTL <= 0 {
+		tg.tokenTTL = defaultTokenTTL
+	}
+	if conf.TokenNotBeforeSkew <= 0 {
+		tg.tokenBeforeTime = -defaultTokenNotBeforeSkew
+	}
+	return tg
 }
 
-// readConfig ...
+// readConfig loads the keys of the KIDs, a key is read again when its file changed. The keys are read from the etc
+// directory if no key directory is configured, the missing keys of the KIDs in rotation are skipped
 func (tg *tokenGenerator) readConfig(logger zap.Logger) (err error) {
 	logger.Info("Entering readConfig")
 	defer func() {
 		logger.Info("Exiting readConfig", zap.Duration("tokenTTL", tg.tokenTTL), zap.Duration("tokenBeforeTime", tg.tokenBeforeTime), zap.String("tokenKID", tg.tokenKID), local.ZapError(err))
 	}()
 
-	if tg.privateKey != nil {
+	if tg.tokenKID == "" {
+		err = errors.New("token KID is not configured")
 		return
 	}
 
-	path := filepath.Join(GetEtcPath(), tg.tokenKID)
+	tg.mutex.Lock()
+	defer tg.mutex.Unlock()
 
-	pem, err := ioutil.ReadFile(filepath.Clean(path))
+	if tg.keys == nil {
+		tg.keys = map[string]*tokenKey{}
+	}
+	err = tg.loadKey(tg.tokenKID, false, logger)
 	if err != nil {
-		logger.Error("Error reading PEM", local.ZapError(err))
 		return
 	}
+	for _, kid := range tg.tokenKIDs {
+		err = tg.loadKey(kid, true, logger)
+		if err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// loadKey reads the key of the KID unless it is loaded already and its file did not change, the caller holds the lock.
+// A missing optional key is skipped
+func (tg *tokenGenerator) loadKey(kid string, optional bool, logger zap.Logger) error {
+	keyDir := tg.keyDir
+	if keyDir == "" {
+		keyDir = GetEtcPath()
+	}
+	path := filepath.Clean(filepath.Join(keyDir, kid))
+
+	info, err := os.Stat(path)
+	if optional && os.IsNotExist(err) {
+		logger.Warn("Token key file not found, skipped the key", zap.String("path", path), zap.String("tokenKID", kid))
+		delete(tg.keys, kid)
+		return nil
+	}
+	if err != nil {
+		logger.Error("Error reading PEM", zap.String("path", path), local.ZapError(err))
+		return fmt.Errorf("token key file '%s' of KID '%s' can not be read: %v", path, kid, err)
+	}
+
+	key, found := tg.keys[kid]
+	if found && key.modTime.Equal(info.ModTime()) && key.size == info.Size() {
+		return nil
+	}
+
+	pem, err := ioutil.ReadFile(path)
+	if err != nil {
+		logger.Error("Error reading PEM", zap.String("path", path), local.ZapError(err))
+		return fmt.Errorf("token key file '%s' of KID '%s' can not be read: %v", path, kid, err)
+	}
 
 	privateKey, err := jwt.ParseRSAPrivateKeyFromPEM(pem)
 	if err != nil {
-		logger.Error("Error parsing PEM", local.ZapError(err))
-		return
+		logger.Error("Error parsing PEM", zap.String("path", path), local.ZapError(err))
+		return fmt.Errorf("token key file '%s' of KID '%s' is not a valid RSA private key: %v", path, kid, err)
 	}
 
-	tg.privateKey = privateKey
+	if found {
+		logger.Info("Token key file changed, reloaded the key", zap.String("path", path), zap.String("tokenKID", kid))
+	}
+	tg.keys[kid] = &tokenKey{
+		privateKey: privateKey,
+		modTime:    info.ModTime(),
+		size:       info.Size(),
+	}
 
-	return
+	return nil
+}
+
+// signingKey returns the loaded key of the KID
+func (tg *tokenGenerator) signingKey(kid string) (*rsa.PrivateKey, error) {
+	tg.mutex.Lock()
+	defer tg.mutex.Unlock()
+
+	key, found := tg.keys[kid]
+	if !found {
+		return nil, errors.New("token key of KID '" + kid + "' is not loaded")
+	}
+	return key.privateKey, nil
 }
 
 // buildToken ...
@@ -120,7 +228,13 @@ func (tg *tokenGenerator) getServiceToken(contextCredentials provider.ContextCre
 		return
 	}
 
-	signedString, err := token.SignedString(tg.privateKey)
+	kid, _ := token.Header["kid"].(string)
+	privateKey, err := tg.signingKey(kid)
+	if err != nil {
+		return
+	}
+
+	signedString, err := token.SignedString(privateKey)
 	if err != nil {
 		return
 	}
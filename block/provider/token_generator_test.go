@@ -18,19 +18,33 @@
 package provider
 
 import (
-	"github.com/stretchr/testify/assert"
-
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/IBM/ibmcloud-volume-interface/lib/provider"
 	"github.com/IBM/ibmcloud-volume-interface/provider/auth"
+	vpcconfig "github.com/IBM/ibmcloud-volume-vpc/block/vpcconfig"
 )
 
+// testTokenKeyDir holds the sample keys
+var testTokenKeyDir = filepath.Join("..", "..", "etc")
+
 func TestTokenGenerator(t *testing.T) {
 	logger, teardown := GetTestLogger(t)
 	defer teardown()
 
-	tg := tokenGenerator{}
+	tg := &tokenGenerator{keyDir: testTokenKeyDir}
 	assert.NotNil(t, tg)
 
 	cf := provider.ContextCredentials{
@@ -54,8 +68,16 @@ func TestTokenGenerator(t *testing.T) {
 		UserID:       TestIKSAccountID,
 	}
 
+	// The key of the KID is not cached from the previous KID
 	tg.tokenKID = "no_sample_key"
 	signedToken, err = tg.getServiceToken(cf, *logger)
+	assert.Nil(t, signedToken)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), filepath.Join(testTokenKeyDir, "no_sample_key"))
+	}
+
+	tg.tokenKID = "sample_key"
+	signedToken, err = tg.getServiceToken(cf, *logger)
 	assert.NotNil(t, signedToken)
 	assert.Nil(t, err)
 
@@ -63,7 +85,6 @@ func TestTokenGenerator(t *testing.T) {
 	assert.NotNil(t, signedToken)
 	assert.Nil(t, err)
 
-	tg.tokenKID = "no_sample_key"
 	cf = provider.ContextCredentials{
 		AuthType:     auth.IMSToken,
 		Credential:   TestProviderAccessToken,
@@ -82,6 +103,146 @@ func TestTokenGenerator(t *testing.T) {
 		UserID:       TestIKSAccountID,
 	}
 	signedToken, err = tg.getServiceToken(cf, *logger)
-	assert.NotNil(t, signedToken)
+	assert.Nil(t, signedToken)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), filepath.Join(testTokenKeyDir, "sample_key_invalid"))
+	}
+
+	// The keys are read from the etc directory if no key directory is configured
+	tg = &tokenGenerator{tokenKID: "no_sample_key"}
+	signedToken, err = tg.getServiceToken(cf, *logger)
+	assert.Nil(t, signedToken)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), filepath.Join(GetEtcPath(), "no_sample_key"))
+	}
+}
+
+func TestNewTokenGenerator(t *testing.T) {
+	testCases := []struct {
+		testCaseName            string
+		conf                    *vpcconfig.VPCBlockConfig
+		expectedKIDs            []string
+		expectedTTL             time.Duration
+		expectedTokenBeforeTime time.Duration
+	}{
+		{
+			testCaseName:            "Defaults",
+			conf:                    &vpcconfig.VPCBlockConfig{TokenKeyDir: testTokenKeyDir, TokenKID: "sample_key"},
+			expectedTTL:             defaultTokenTTL,
+			expectedTokenBeforeTime: -defaultTokenNotBeforeSkew,
+		}, {
+			testCaseName: "Configured",
+			conf: &vpcconfig.VPCBlockConfig{
+				TokenKeyDir:        testTokenKeyDir,
+				TokenKID:           "sample_key",
+				TokenKIDs:          []string{"sample_key", "old_key"},
+				TokenTTL:           time.Hour,
+				TokenNotBeforeSkew: 5 * time.Minute,
+			},
+			expectedKIDs:            []string{"old_key"},
+			expectedTTL:             time.Hour,
+			expectedTokenBeforeTime: -5 * time.Minute,
+		},
+	}
+
+	for _, testcase := range testCases {
+		t.Run(testcase.testCaseName, func(t *testing.T) {
+			tg := newTokenGenerator(testcase.conf)
+			assert.Equal(t, testTokenKeyDir, tg.keyDir)
+			assert.Equal(t, "sample_key", tg.tokenKID)
+			assert.Equal(t, testcase.expectedKIDs, tg.tokenKIDs)
+			assert.Equal(t, testcase.expectedTTL, tg.tokenTTL)
+			assert.Equal(t, testcase.expectedTokenBeforeTime, tg.tokenBeforeTime)
+		})
+	}
+}
+
+func TestTokenGeneratorKeyRotation(t *testing.T) {
+	logger, teardown := GetTestLogger(t)
+	defer teardown()
+
+	keyDir, err := ioutil.TempDir("", "token-keys")
+	require.NoError(t, err)
+	defer os.RemoveAll(keyDir)
+
+	writeKey := func(kid string, modTime time.Time) *rsa.PrivateKey {
+		privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})
+		path := filepath.Join(keyDir, kid)
+		require.NoError(t, ioutil.WriteFile(path, keyPEM, 0600))
+		require.NoError(t, os.Chtimes(path, modTime, modTime))
+		return privateKey
+	}
+	verify := func(signedToken *string, kid string, publicKey *rsa.PublicKey) jwt.MapClaims {
+		claims := jwt.MapClaims{}
+		token, err := jwt.ParseWithClaims(*signedToken, claims, func(token *jwt.Token) (interface{}, error) {
+			assert.Equal(t, kid, token.Header["kid"])
+			return publicKey, nil
+		})
+		if assert.Nil(t, err) {
+			assert.True(t, token.Valid)
+		}
+		return claims
+	}
+
+	modTime := time.Now().Add(-time.Hour)
+	activeKey := writeKey("active_key", modTime)
+	tg := newTokenGenerator(&vpcconfig.VPCBlockConfig{
+		TokenKeyDir:        keyDir,
+		TokenKID:           "active_key",
+		TokenKIDs:          []string{"next_key"},
+		TokenTTL:           time.Hour,
+		TokenNotBeforeSkew: 2 * time.Minute,
+	})
+	cf := provider.ContextCredentials{
+		AuthType:     auth.IMSToken,
+		Credential:   TestProviderAccessToken,
+		IAMAccountID: TestIKSAccountID,
+		UserID:       TestIKSAccountID,
+	}
+
+	// The missing key of a KID in rotation is skipped
+	signedToken, err := tg.getServiceToken(cf, *logger)
+	assert.Nil(t, err)
+	if assert.NotNil(t, signedToken) {
+		verify(signedToken, "active_key", &activeKey.PublicKey)
+	}
+	assert.NotContains(t, tg.keys, "next_key")
+
+	// The tokens are signed by the active key only
+	writeKey("next_key", modTime)
+	ts := time.Now()
+	signedToken, err = tg.getServiceToken(cf, *logger)
+	assert.Contains(t, tg.keys, "next_key")
+	assert.Nil(t, err)
+	if assert.NotNil(t, signedToken) {
+		claims := verify(signedToken, "active_key", &activeKey.PublicKey)
+		assert.InDelta(t, ts.Add(time.Hour).Unix(), claims["exp"], 1)
+		assert.InDelta(t, ts.Add(-2*time.Minute).Unix(), claims["nbf"], 1)
+		assert.Equal(t, TestIKSAccountID, claims["ims_user_id"])
+	}
+
+	// The changed key file is read again
+	activeKey = writeKey("active_key", modTime.Add(time.Minute))
+	signedToken, err = tg.getServiceToken(cf, *logger)
+	assert.Nil(t, err)
+	if assert.NotNil(t, signedToken) {
+		verify(signedToken, "active_key", &activeKey.PublicKey)
+	}
+
+	// The removed key file of a KID in rotation is dropped
+	require.NoError(t, os.Remove(filepath.Join(keyDir, "next_key")))
+	signedToken, err = tg.getServiceToken(cf, *logger)
 	assert.Nil(t, err)
+	assert.NotNil(t, signedToken)
+	assert.NotContains(t, tg.keys, "next_key")
+
+	// The removed key file of the active KID is reported
+	require.NoError(t, os.Remove(filepath.Join(keyDir, "active_key")))
+	signedToken, err = tg.getServiceToken(cf, *logger)
+	assert.Nil(t, signedToken)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), filepath.Join(keyDir, "active_key"))
+	}
 }
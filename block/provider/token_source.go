@@ -0,0 +1,127 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package provider ...
+package provider
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/IBM/ibmcloud-volume-interface/provider/local"
+	vpcauth "github.com/IBM/ibmcloud-volume-vpc/common/auth"
+	vpciam "github.com/IBM/ibmcloud-volume-vpc/common/iam"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/client"
+	"go.uber.org/zap"
+)
+
+// tokenRefreshAhead is the time before the expiry of the token from when it is renewed
+const tokenRefreshAhead = 5 * time.Minute
+
+// sessionTokenSource provides the IAM access token of a session. The token is exchanged again through the
+// context credentials factory before it expires and when it is rejected by the API
+type sessionTokenSource struct {
+	contextCF local.ContextCredentialsFactory
+	apiKey    string
+	logger    *zap.Logger
+
+	mutex  sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+var _ client.TokenSource = &sessionTokenSource{}
+
+// newSessionTokenSource returns the token source for the token of the session
+func newSessionTokenSource(token string, contextCF local.ContextCredentialsFactory, apiKey string, logger *zap.Logger) *sessionTokenSource {
+	tokenSource := &sessionTokenSource{
+		contextCF: contextCF,
+		apiKey:    apiKey,
+		logger:    logger,
+	}
+	tokenSource.setToken(token)
+	return tokenSource
+}
+
+// Token returns the token of the session, it is renewed if it is about to expire
+func (ts *sessionTokenSource) Token() (string, error) {
+	ts.mutex.Lock()
+	defer ts.mutex.Unlock()
+
+	if ts.expiry.IsZero() || time.Now().Add(tokenRefreshAhead).Before(ts.expiry) {
+		return ts.token, nil
+	}
+
+	ts.logger.Info("Access token is about to expire, renewing it", zap.Time("Expiry", ts.expiry))
+	err := ts.refresh()
+	if err != nil {
+		// The token may still be accepted, the request fails otherwise and is sent again after a refresh
+		if time.Now().Before(ts.expiry) {
+			ts.logger.Warn("Failed to renew the access token, using the current one", zap.Error(err))
+			return ts.token, nil
+		}
+		return "", err
+	}
+	return ts.token, nil
+}
+
+// Refresh renews the token rejected by the API, unless it is already renewed
+func (ts *sessionTokenSource) Refresh(rejectedToken string) (string, error) {
+	ts.mutex.Lock()
+	defer ts.mutex.Unlock()
+
+	if rejectedToken != ts.token {
+		return ts.token, nil
+	}
+
+	ts.logger.Info("Access token is rejected by the API, renewing it")
+	// The token is shared by the sessions through the token cache
+	vpcauth.InvalidateAccessToken(rejectedToken)
+	err := ts.refresh()
+	if err != nil {
+		return "", err
+	}
+	return ts.token, nil
+}
+
+// refresh exchanges the API key or the compute resource token for a new token, the caller holds the lock
+func (ts *sessionTokenSource) refresh() error {
+	// The API key is empty with compute resource token authentication, the token is exchanged all the same
+	if ts.contextCF == nil {
+		return errors.New("access token can not be renewed without context credentials factory")
+	}
+	contextCredentials, err := ts.contextCF.ForIAMAccessToken(ts.apiKey, ts.logger)
+	if err != nil {
+		ts.logger.Error("Failed to renew the access token", zap.Error(err))
+		return err
+	}
+	ts.setToken(contextCredentials.Credential)
+	ts.logger.Info("Renewed the access token", zap.Time("Expiry", ts.expiry))
+	return nil
+}
+
+// setToken keeps the token along with its expiry
+func (ts *sessionTokenSource) setToken(token string) {
+	ts.token = token
+	ts.expiry = time.Time{}
+	claims, err := vpciam.ParseAccessToken(token)
+	if err != nil {
+		ts.logger.Debug("Expiry of the access token is unknown", zap.Error(err))
+		return
+	}
+	ts.expiry = claims.Expiry()
+}
@@ -0,0 +1,237 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package provider ...
+package provider
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IBM/ibmcloud-volume-interface/lib/provider"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/riaas/fakes"
+	"github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// testContextCredentialsFactory exchanges the API key for the given tokens
+type testContextCredentialsFactory struct {
+	mutex  sync.Mutex
+	tokens []string
+	err    error
+	calls  int
+	apiKey string
+	// accountID is the account of the exchanged tokens
+	accountID string
+}
+
+func (ccf *testContextCredentialsFactory) ForIaaSAPIKey(iamAccountID, iaasUserID, iaasAPIKey string, logger *zap.Logger) (provider.ContextCredentials, error) {
+	return provider.ContextCredentials{}, errors.New("not supported")
+}
+
+func (ccf *testContextCredentialsFactory) ForIAMAPIKey(iamAccountID, iamAPIKey string, logger *zap.Logger) (provider.ContextCredentials, error) {
+	return provider.ContextCredentials{}, errors.New("not supported")
+}
+
+func (ccf *testContextCredentialsFactory) ForIAMAccessToken(apiKey string, logger *zap.Logger) (provider.ContextCredentials, error) {
+	ccf.mutex.Lock()
+	defer ccf.mutex.Unlock()
+	ccf.calls++
+	ccf.apiKey = apiKey
+	if ccf.err != nil {
+		return provider.ContextCredentials{}, ccf.err
+	}
+	token := ccf.tokens[0]
+	ccf.tokens = ccf.tokens[1:]
+	return provider.ContextCredentials{AuthType: provider.IAMAccessToken, Credential: token, IAMAccountID: ccf.accountID}, nil
+}
+
+func getTestAccessToken(t *testing.T, expiry time.Time) string {
+	claims := jwt.MapClaims{"iam_id": "test-iam-id"}
+	if !expiry.IsZero() {
+		claims["exp"] = expiry.Unix()
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("test-secret"))
+	require.NoError(t, err)
+	return token
+}
+
+func TestSessionTokenSource(t *testing.T) {
+	logger, teardown := GetTestLogger(t)
+	defer teardown()
+
+	validToken := getTestAccessToken(t, time.Now().Add(time.Hour))
+	expiringToken := getTestAccessToken(t, time.Now().Add(time.Minute))
+	expiredToken := getTestAccessToken(t, time.Now().Add(-time.Minute))
+	renewedToken := getTestAccessToken(t, time.Now().Add(2*time.Hour))
+
+	testCases := []struct {
+		testCaseName  string
+		token         string
+		exchangeErr   error
+		expectedToken string
+		expectedErr   bool
+		expectedCalls int
+	}{
+		{
+			testCaseName:  "Valid token is used as is",
+			token:         validToken,
+			expectedToken: validToken,
+		}, {
+			testCaseName:  "Token without expiry is used as is",
+			token:         "not a JWT token",
+			expectedToken: "not a JWT token",
+		}, {
+			testCaseName:  "Token is renewed before it expires",
+			token:         expiringToken,
+			expectedToken: renewedToken,
+			expectedCalls: 1,
+		}, {
+			testCaseName:  "Expiring token is used when it can not be renewed",
+			token:         expiringToken,
+			exchangeErr:   errors.New("token exchange failed"),
+			expectedToken: expiringToken,
+			expectedCalls: 1,
+		}, {
+			testCaseName:  "Expired token which can not be renewed",
+			token:         expiredToken,
+			exchangeErr:   errors.New("token exchange failed"),
+			expectedErr:   true,
+			expectedCalls: 1,
+		},
+	}
+
+	for _, testcase := range testCases {
+		t.Run(testcase.testCaseName, func(t *testing.T) {
+			ccf := &testContextCredentialsFactory{tokens: []string{renewedToken}, err: testcase.exchangeErr}
+			tokenSource := newSessionTokenSource(testcase.token, ccf, "test-api-key", logger)
+
+			token, err := tokenSource.Token()
+			if testcase.expectedErr {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+			assert.Equal(t, testcase.expectedToken, token)
+			assert.Equal(t, testcase.expectedCalls, ccf.calls)
+			if ccf.calls > 0 {
+				assert.Equal(t, "test-api-key", ccf.apiKey)
+			}
+		})
+	}
+}
+
+func TestSessionTokenSourceRefresh(t *testing.T) {
+	logger, teardown := GetTestLogger(t)
+	defer teardown()
+
+	validToken := getTestAccessToken(t, time.Now().Add(time.Hour))
+	renewedToken := getTestAccessToken(t, time.Now().Add(2*time.Hour))
+	ccf := &testContextCredentialsFactory{tokens: []string{renewedToken}}
+	tokenSource := newSessionTokenSource(validToken, ccf, "test-api-key", logger)
+
+	// The concurrent requests rejected for the same token renew it once
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			token, err := tokenSource.Refresh(validToken)
+			assert.Nil(t, err)
+			assert.Equal(t, renewedToken, token)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 1, ccf.calls)
+
+	token, err := tokenSource.Token()
+	assert.Nil(t, err)
+	assert.Equal(t, renewedToken, token)
+
+	// The token can not be renewed
+	ccf.err = errors.New("token exchange failed")
+	token, err = tokenSource.Refresh(renewedToken)
+	assert.NotNil(t, err)
+	assert.Equal(t, "", token)
+
+	// The token can not be renewed without API key
+	tokenSource = newSessionTokenSource(validToken, nil, "", logger)
+	_, err = tokenSource.Refresh(validToken)
+	assert.NotNil(t, err)
+}
+
+func TestOpenSessionWithTokenSource(t *testing.T) {
+	logger, teardown := GetTestLogger(t)
+	defer teardown()
+
+	vpcp, err := GetTestProvider(t, logger)
+	require.NoError(t, err)
+	uc := &fakes.RegionalAPI{}
+	cp := &fakes.RegionalAPIClientProvider{}
+	cp.NewReturns(uc, nil)
+	vpcp.ClientProvider = cp
+	contextCredentials := provider.ContextCredentials{
+		AuthType:     provider.IAMAccessToken,
+		Credential:   TestProviderAccessToken,
+		IAMAccountID: TestIKSAccountID,
+	}
+
+	// Without context credentials factory the token is not renewed
+	_, err = vpcp.OpenSession(context.Background(), contextCredentials, logger)
+	require.NoError(t, err)
+	assert.Equal(t, 1, uc.LoginCallCount())
+	assert.Equal(t, 0, uc.LoginWithTokenSourceCallCount())
+
+	vpcp.ContextCF = &testContextCredentialsFactory{}
+	vpcp.Config.VPCConfig.APIKey = "test-api-key"
+	_, err = vpcp.OpenSession(context.Background(), contextCredentials, logger)
+	require.NoError(t, err)
+	assert.Equal(t, 1, uc.LoginCallCount())
+	if assert.Equal(t, 1, uc.LoginWithTokenSourceCallCount()) {
+		tokenSource, ok := uc.LoginWithTokenSourceArgsForCall(0).(*sessionTokenSource)
+		if assert.True(t, ok) {
+			token, err := tokenSource.Token()
+			assert.Nil(t, err)
+			assert.Equal(t, TestProviderAccessToken, token)
+			assert.Equal(t, "test-api-key", tokenSource.apiKey)
+		}
+	}
+
+	// With compute resource token authentication there is no API key
+	ccf := &testContextCredentialsFactory{tokens: []string{"renewed-token"}, apiKey: "unset"}
+	vpcp.ContextCF = ccf
+	vpcp.Config.VPCConfig.APIKey = ""
+	vpcp.Config.TrustedProfileID = "test-profile-id"
+	vpcp.Config.ComputeResourceTokenFile = "/var/run/secrets/tokens/sa-token"
+	_, err = vpcp.OpenSession(context.Background(), contextCredentials, logger)
+	require.NoError(t, err)
+	assert.Equal(t, 1, uc.LoginCallCount())
+	if assert.Equal(t, 2, uc.LoginWithTokenSourceCallCount()) {
+		tokenSource, ok := uc.LoginWithTokenSourceArgsForCall(1).(*sessionTokenSource)
+		if assert.True(t, ok) {
+			token, err := tokenSource.Refresh(TestProviderAccessToken)
+			assert.Nil(t, err)
+			assert.Equal(t, "renewed-token", token)
+			assert.Equal(t, 1, ccf.calls)
+			assert.Equal(t, "", ccf.apiKey)
+		}
+	}
+}
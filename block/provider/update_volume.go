@@ -18,12 +18,81 @@
 package provider
 
 import (
-	"errors"
+	"time"
 
+	"github.com/IBM/ibmcloud-volume-interface/lib/metrics"
 	"github.com/IBM/ibmcloud-volume-interface/lib/provider"
+	userError "github.com/IBM/ibmcloud-volume-vpc/common/messages"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
+	"go.uber.org/zap"
 )
 
-// UpdateVolume POSTs to /volumes
-func (vpc *VPCSession) UpdateVolume(volumeTemplate provider.Volume) error {
-	return errors.New("unsupported Operation")
+// maxExpandSize is the largest capacity (in GB) a volume can be expanded to. It is the upper bound across all
+// the volume profiles, the limit of the volume's own profile is checked once the volume details are known
+const maxExpandSize = 2000
+
+// UpdateVolume expands the capacity of an existing volume
+func (vpcs *VPCSession) UpdateVolume(volumeRequest provider.Volume) (err error) {
+	vpcs.Logger.Debug("Entry of UpdateVolume method...")
+	defer vpcs.Logger.Debug("Exit from UpdateVolume method...")
+	defer metrics.UpdateDurationFromStart(vpcs.Logger, "UpdateVolume", time.Now())
+
+	vpcs.Logger.Info("Basic validation for UpdateVolume request... ", zap.Reflect("RequestedVolumeDetails", volumeRequest))
+	err = validateVolumeID(volumeRequest.VolumeID)
+	if err != nil {
+		return err
+	}
+
+	if volumeRequest.Capacity == nil {
+		return userError.GetUserError("VolumeCapacityInvalid", nil, nil)
+	} else if *volumeRequest.Capacity > maxExpandSize {
+		return userError.GetUserError("VolumeCapacityInvalid", nil, *volumeRequest.Capacity)
+	}
+	newCapacity := int64(*volumeRequest.Capacity)
+
+	vpcs.Logger.Info("Getting volume details from VPC provider...", zap.Reflect("VolumeID", volumeRequest.VolumeID))
+	var existingVolume *models.Volume
+	err = vpcs.APIRetry.Retry(vpcs.GetContext(), vpcs.Logger, func() error {
+		existingVolume, err = vpcs.Apiclient.WithContext(vpcs.GetContext()).VolumeService().GetVolume(volumeRequest.VolumeID, vpcs.Logger)
+		return err
+	})
+	if err != nil {
+		return userError.GetUserError("StorageFindFailedWithVolumeId", err, volumeRequest.VolumeID)
+	}
+
+	// Only expansion is supported by the VPC volumes
+	if newCapacity <= existingVolume.Capacity {
+		return userError.GetUserError("VolumeCapacityShrinkInvalid", nil, newCapacity, existingVolume.Capacity)
+	}
+	if existingVolume.Profile != nil {
+		err = validateVolumeProfile(vpcs, existingVolume.Profile.Name, newCapacity, 0)
+		if err != nil {
+			return err
+		}
+	}
+
+	volumePatch := &models.VolumePatch{
+		Capacity: newCapacity,
+	}
+
+	vpcs.Logger.Info("Calling VPC provider for volume expansion...", zap.Reflect("VolumePatch", volumePatch))
+	err = vpcs.APIRetry.Retry(vpcs.GetContext(), vpcs.Logger, func() error {
+		_, err = vpcs.Apiclient.WithContext(vpcs.GetContext()).VolumeService().PatchVolume(volumeRequest.VolumeID, volumePatch, vpcs.Logger)
+		return err
+	})
+	if err != nil {
+		vpcs.Logger.Debug("Failed to expand volume from VPC provider", zap.Reflect("BackendError", err))
+		return userError.GetUserError("FailedToExpandVolume", err, volumeRequest.VolumeID)
+	}
+
+	vpcs.Logger.Info("Waiting for volume to be in valid (available) state with new capacity", zap.Reflect("VolumeID", volumeRequest.VolumeID))
+	_, err = WaitForVolumeUpdate(vpcs, volumeRequest.VolumeID, func(volume *models.Volume) bool {
+		return volume.Capacity == newCapacity
+	})
+	if err != nil {
+		return err
+	}
+
+	vpcs.Logger.Info("Successfully expanded the volume", zap.Reflect("VolumeID", volumeRequest.VolumeID), zap.Reflect("Capacity", newCapacity))
+	return nil
 }
@@ -0,0 +1,119 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package provider ...
+package provider
+
+import (
+	"errors"
+	"time"
+
+	"github.com/IBM/ibmcloud-volume-interface/lib/metrics"
+	"github.com/IBM/ibmcloud-volume-interface/lib/provider"
+	"github.com/IBM/ibmcloud-volume-interface/lib/utils/reasoncode"
+	userError "github.com/IBM/ibmcloud-volume-vpc/common/messages"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
+	"go.uber.org/zap"
+)
+
+// UpdateVolumeProfile moves an existing volume to another profile, or changes the IOPS of a custom profile volume
+func (vpcs *VPCSession) UpdateVolumeProfile(volumeRequest provider.Volume) (volumeResponse *provider.Volume, err error) {
+	vpcs.Logger.Debug("Entry of UpdateVolumeProfile method...")
+	defer vpcs.Logger.Debug("Exit from UpdateVolumeProfile method...")
+	defer metrics.UpdateDurationFromStart(vpcs.Logger, "UpdateVolumeProfile", time.Now())
+
+	vpcs.Logger.Info("Basic validation for UpdateVolumeProfile request... ", zap.Reflect("RequestedVolumeDetails", volumeRequest))
+	err = validateVolumeID(volumeRequest.VolumeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if volumeRequest.VPCVolume.Profile == nil || len(volumeRequest.VPCVolume.Profile.Name) == 0 {
+		return nil, userError.GetUserError(string(reasoncode.ErrorRequiredFieldMissing), nil, "Profile")
+	}
+	profileName := volumeRequest.VPCVolume.Profile.Name
+
+	// IOPS can be set only for the custom profile, tiered profiles derive it from the capacity
+	var iops int64
+	if volumeRequest.Iops != nil {
+		iops = ToInt64(*volumeRequest.Iops)
+	}
+	if profileName != customProfile && iops > 0 {
+		return nil, userError.GetUserError("VolumeProfileIopsInvalid", nil)
+	}
+	if profileName == customProfile && iops <= 0 {
+		var requestedIops string
+		if volumeRequest.Iops != nil {
+			requestedIops = *volumeRequest.Iops
+		}
+		return nil, userError.GetUserError("IopsInvalid", nil, requestedIops)
+	}
+
+	vpcs.Logger.Info("Getting volume details from VPC provider...", zap.Reflect("VolumeID", volumeRequest.VolumeID))
+	var volume *models.Volume
+	err = vpcs.APIRetry.Retry(vpcs.GetContext(), vpcs.Logger, func() error {
+		volume, err = vpcs.Apiclient.WithContext(vpcs.GetContext()).VolumeService().GetVolume(volumeRequest.VolumeID, vpcs.Logger)
+		return err
+	})
+	if err != nil {
+		return nil, userError.GetUserError("StorageFindFailedWithVolumeId", err, volumeRequest.VolumeID)
+	}
+
+	// The capacity of the volume must be in the range of the target profile, as for new volumes
+	err = validateVolumeProfile(vpcs, profileName, volume.Capacity, iops)
+	if err != nil {
+		return nil, err
+	}
+
+	isUpdated := func(volume *models.Volume) bool {
+		return volume.Profile != nil && volume.Profile.Name == profileName && (iops == 0 || volume.Iops == iops)
+	}
+
+	if isUpdated(volume) {
+		vpcs.Logger.Info("Volume already has the requested profile and IOPS", zap.Reflect("VolumeDetails", volume))
+	} else {
+		volumePatch := &models.VolumePatch{
+			Iops: iops,
+		}
+		if volume.Profile == nil || volume.Profile.Name != profileName {
+			volumePatch.Profile = &models.Profile{Name: profileName}
+		}
+
+		vpcs.Logger.Info("Calling VPC provider for volume profile update...", zap.Reflect("VolumePatch", volumePatch))
+		err = vpcs.APIRetry.Retry(vpcs.GetContext(), vpcs.Logger, func() error {
+			_, err = vpcs.Apiclient.WithContext(vpcs.GetContext()).VolumeService().PatchVolume(volumeRequest.VolumeID, volumePatch, vpcs.Logger)
+			return err
+		})
+		if err != nil {
+			vpcs.Logger.Debug("Failed to update volume profile from VPC provider", zap.Reflect("BackendError", err))
+			return nil, userError.GetUserError("FailedToUpdateVolumeProfile", err, volumeRequest.VolumeID, profileName)
+		}
+
+		vpcs.Logger.Info("Waiting for volume to be in valid (available) state with new profile", zap.Reflect("VolumeID", volumeRequest.VolumeID))
+		volume, err = WaitForVolumeUpdate(vpcs, volumeRequest.VolumeID, isUpdated)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Converting volume to lib volume type
+	volumeResponse = FromProviderToLibVolume(volume, vpcs.Logger)
+	if volumeResponse == nil {
+		return nil, userError.GetUserError("FailedToUpdateVolumeProfile", errors.New("incomplete volume details returned by the backend"), volumeRequest.VolumeID, profileName)
+	}
+	vpcs.Logger.Info("VolumeResponse", zap.Reflect("volumeResponse", volumeResponse))
+	return volumeResponse, nil
+}
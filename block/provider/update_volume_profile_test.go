@@ -0,0 +1,280 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package provider ...
+package provider
+
+import (
+	"testing"
+
+	"github.com/IBM/ibmcloud-volume-interface/lib/provider"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
+	volumeServiceFakes "github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/vpcvolume/fakes"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestUpdateVolumeProfile(t *testing.T) {
+	//var err error
+	logger, teardown := GetTestLogger(t)
+	defer teardown()
+
+	var (
+		volumeService *volumeServiceFakes.VolumeService
+	)
+
+	testCases := []struct {
+		testCaseName   string
+		providerVolume provider.Volume
+		baseVolume     *models.Volume
+		updatedVolume  *models.Volume
+		patchErr       error
+		profile        *models.VolumeProfile
+		profileErr     error
+
+		expectedErr string
+
+		verify func(t *testing.T, volumeResponse *provider.Volume, err error)
+	}{
+		{
+			testCaseName: "Volume moved to another tiered profile",
+			providerVolume: provider.Volume{
+				VolumeID: "16f293bf-test-4bff-816f-e199c0c65db5",
+				VPCVolume: provider.VPCVolume{
+					Profile: &provider.Profile{Name: "10iops-tier"},
+				},
+			},
+			baseVolume: &models.Volume{
+				ID:       "16f293bf-test-4bff-816f-e199c0c65db5",
+				Capacity: int64(10),
+				Iops:     int64(30),
+				Status:   models.StatusType("available"),
+				Profile:  &models.Profile{Name: "general-purpose"},
+				Zone:     &models.Zone{Name: "test-zone"},
+			},
+			updatedVolume: &models.Volume{
+				ID:       "16f293bf-test-4bff-816f-e199c0c65db5",
+				Capacity: int64(10),
+				Iops:     int64(100),
+				Status:   models.StatusType("available"),
+				Profile:  &models.Profile{Name: "10iops-tier"},
+				Zone:     &models.Zone{Name: "test-zone"},
+			},
+			verify: func(t *testing.T, volumeResponse *provider.Volume, err error) {
+				assert.Nil(t, err)
+				assert.NotNil(t, volumeResponse)
+				assert.Equal(t, "10iops-tier", volumeResponse.VPCVolume.Profile.Name)
+				_, volumePatch, _ := volumeService.PatchVolumeArgsForCall(0)
+				assert.Equal(t, "10iops-tier", volumePatch.Profile.Name)
+				assert.Equal(t, int64(0), volumePatch.Iops)
+			},
+		}, {
+			testCaseName: "IOPS changed on custom profile",
+			providerVolume: provider.Volume{
+				VolumeID: "16f293bf-test-4bff-816f-e199c0c65db5",
+				Iops:     String("2000"),
+				VPCVolume: provider.VPCVolume{
+					Profile: &provider.Profile{Name: "custom"},
+				},
+			},
+			baseVolume: &models.Volume{
+				ID:       "16f293bf-test-4bff-816f-e199c0c65db5",
+				Capacity: int64(100),
+				Iops:     int64(1000),
+				Status:   models.StatusType("available"),
+				Profile:  &models.Profile{Name: "custom"},
+				Zone:     &models.Zone{Name: "test-zone"},
+			},
+			updatedVolume: &models.Volume{
+				ID:       "16f293bf-test-4bff-816f-e199c0c65db5",
+				Capacity: int64(100),
+				Iops:     int64(2000),
+				Status:   models.StatusType("available"),
+				Profile:  &models.Profile{Name: "custom"},
+				Zone:     &models.Zone{Name: "test-zone"},
+			},
+			verify: func(t *testing.T, volumeResponse *provider.Volume, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, "2000", *volumeResponse.Iops)
+				_, volumePatch, _ := volumeService.PatchVolumeArgsForCall(0)
+				assert.Nil(t, volumePatch.Profile)
+				assert.Equal(t, int64(2000), volumePatch.Iops)
+			},
+		}, {
+			testCaseName: "Volume already has the requested profile",
+			providerVolume: provider.Volume{
+				VolumeID: "16f293bf-test-4bff-816f-e199c0c65db5",
+				VPCVolume: provider.VPCVolume{
+					Profile: &provider.Profile{Name: "general-purpose"},
+				},
+			},
+			baseVolume: &models.Volume{
+				ID:       "16f293bf-test-4bff-816f-e199c0c65db5",
+				Capacity: int64(10),
+				Status:   models.StatusType("available"),
+				Profile:  &models.Profile{Name: "general-purpose"},
+				Zone:     &models.Zone{Name: "test-zone"},
+			},
+			verify: func(t *testing.T, volumeResponse *provider.Volume, err error) {
+				assert.Nil(t, err)
+				assert.NotNil(t, volumeResponse)
+				assert.Equal(t, 0, volumeService.PatchVolumeCallCount())
+			},
+		}, {
+			testCaseName: "Volume returned without zone",
+			providerVolume: provider.Volume{
+				VolumeID: "16f293bf-test-4bff-816f-e199c0c65db5",
+				VPCVolume: provider.VPCVolume{
+					Profile: &provider.Profile{Name: "general-purpose"},
+				},
+			},
+			baseVolume: &models.Volume{
+				ID:       "16f293bf-test-4bff-816f-e199c0c65db5",
+				Capacity: int64(10),
+				Status:   models.StatusType("available"),
+				Profile:  &models.Profile{Name: "general-purpose"},
+			},
+			verify: func(t *testing.T, volumeResponse *provider.Volume, err error) {
+				assert.Nil(t, err)
+				if assert.NotNil(t, volumeResponse) {
+					assert.Equal(t, "", volumeResponse.Az)
+					assert.Equal(t, "general-purpose", volumeResponse.VPCVolume.Profile.Name)
+				}
+			},
+		}, {
+			testCaseName: "Profile is empty",
+			providerVolume: provider.Volume{
+				VolumeID: "16f293bf-test-4bff-816f-e199c0c65db5",
+			},
+			expectedErr: "ErrorRequiredFieldMissing",
+		}, {
+			testCaseName: "IOPS with tiered profile",
+			providerVolume: provider.Volume{
+				VolumeID: "16f293bf-test-4bff-816f-e199c0c65db5",
+				Iops:     String("2000"),
+				VPCVolume: provider.VPCVolume{
+					Profile: &provider.Profile{Name: "5iops-tier"},
+				},
+			},
+			expectedErr: "VolumeProfileIopsInvalid",
+		}, {
+			testCaseName: "Custom profile without IOPS",
+			providerVolume: provider.Volume{
+				VolumeID: "16f293bf-test-4bff-816f-e199c0c65db5",
+				VPCVolume: provider.VPCVolume{
+					Profile: &provider.Profile{Name: "custom"},
+				},
+			},
+			expectedErr: "IopsInvalid",
+		}, {
+			testCaseName: "Profile update failed",
+			providerVolume: provider.Volume{
+				VolumeID: "16f293bf-test-4bff-816f-e199c0c65db5",
+				VPCVolume: provider.VPCVolume{
+					Profile: &provider.Profile{Name: "10iops-tier"},
+				},
+			},
+			baseVolume: &models.Volume{
+				ID:       "16f293bf-test-4bff-816f-e199c0c65db5",
+				Capacity: int64(10),
+				Status:   models.StatusType("available"),
+				Profile:  &models.Profile{Name: "general-purpose"},
+				Zone:     &models.Zone{Name: "test-zone"},
+			},
+			patchErr:    &models.Error{Errors: []models.ErrorItem{{Code: "volume_profile_iops_invalid", Message: "profile not supported"}}},
+			expectedErr: "FailedToUpdateVolumeProfile",
+		}, {
+			testCaseName: "Capacity out of the range of the profile",
+			providerVolume: provider.Volume{
+				VolumeID: "16f293bf-test-4bff-816f-e199c0c65db5",
+				VPCVolume: provider.VPCVolume{
+					Profile: &provider.Profile{Name: "10iops-tier"},
+				},
+			},
+			baseVolume: &models.Volume{
+				ID:       "16f293bf-test-4bff-816f-e199c0c65db5",
+				Capacity: int64(16000),
+				Status:   models.StatusType("available"),
+				Profile:  &models.Profile{Name: "general-purpose"},
+				Zone:     &models.Zone{Name: "test-zone"},
+			},
+			profile: &models.VolumeProfile{
+				Name:     "10iops-tier",
+				Capacity: &models.ProfileRange{Type: models.ProfileRangeTypeRange, Min: 10, Max: 9600},
+			},
+			expectedErr: "VolumeProfileCapacityInvalid",
+			verify: func(t *testing.T, volumeResponse *provider.Volume, err error) {
+				assert.Equal(t, 0, volumeService.PatchVolumeCallCount())
+			},
+		}, {
+			testCaseName: "Profile not found",
+			providerVolume: provider.Volume{
+				VolumeID: "16f293bf-test-4bff-816f-e199c0c65db5",
+				VPCVolume: provider.VPCVolume{
+					Profile: &provider.Profile{Name: "unknown-profile"},
+				},
+			},
+			baseVolume: &models.Volume{
+				ID:       "16f293bf-test-4bff-816f-e199c0c65db5",
+				Capacity: int64(10),
+				Status:   models.StatusType("available"),
+				Profile:  &models.Profile{Name: "general-purpose"},
+				Zone:     &models.Zone{Name: "test-zone"},
+			},
+			profileErr:  &models.Error{Errors: []models.ErrorItem{{Code: models.ErrorCodeNotFound, Message: "profile not found"}}},
+			expectedErr: "VolumeProfileNotFound",
+			verify: func(t *testing.T, volumeResponse *provider.Volume, err error) {
+				assert.Equal(t, 0, volumeService.PatchVolumeCallCount())
+			},
+		},
+	}
+
+	for _, testcase := range testCases {
+		t.Run(testcase.testCaseName, func(t *testing.T) {
+			vpcs, uc, sc, err := GetTestOpenSession(t, logger)
+			assert.NotNil(t, vpcs)
+			assert.NotNil(t, uc)
+			assert.NotNil(t, sc)
+			assert.Nil(t, err)
+
+			volumeService = &volumeServiceFakes.VolumeService{}
+			assert.NotNil(t, volumeService)
+			uc.VolumeServiceReturns(volumeService)
+
+			profileService := &volumeServiceFakes.ProfileService{}
+			profileService.GetVolumeProfileReturns(testcase.profile, testcase.profileErr)
+			uc.ProfileServiceReturns(profileService)
+
+			volumeService.GetVolumeReturnsOnCall(0, testcase.baseVolume, nil)
+			volumeService.GetVolumeReturns(testcase.updatedVolume, nil)
+			volumeService.PatchVolumeReturns(testcase.updatedVolume, testcase.patchErr)
+
+			volumeResponse, err := vpcs.UpdateVolumeProfile(testcase.providerVolume)
+			logger.Info("Volume details", zap.Reflect("volumeResponse", volumeResponse))
+
+			if testcase.expectedErr != "" {
+				assert.NotNil(t, err)
+				assert.Nil(t, volumeResponse)
+				logger.Info("Error details", zap.Reflect("Error details", err.Error()))
+				assert.Contains(t, err.Error(), testcase.expectedErr)
+			}
+
+			if testcase.verify != nil {
+				testcase.verify(t, volumeResponse, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,180 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package provider ...
+package provider
+
+import (
+	"testing"
+
+	"github.com/IBM/ibmcloud-volume-interface/lib/provider"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
+	volumeServiceFakes "github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/vpcvolume/fakes"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestUpdateVolume(t *testing.T) {
+	//var err error
+	logger, teardown := GetTestLogger(t)
+	defer teardown()
+
+	var (
+		volumeService *volumeServiceFakes.VolumeService
+	)
+
+	testCases := []struct {
+		testCaseName   string
+		providerVolume provider.Volume
+		baseVolume     *models.Volume
+		updatedVolume  *models.Volume
+		profile        *models.VolumeProfile
+		patchErr       error
+
+		expectedErr string
+
+		verify func(t *testing.T, err error)
+	}{
+		{
+			testCaseName: "Volume expanded",
+			providerVolume: provider.Volume{
+				VolumeID: "16f293bf-test-4bff-816f-e199c0c65db5",
+				Capacity: Int(20),
+			},
+			baseVolume: &models.Volume{
+				ID:       "16f293bf-test-4bff-816f-e199c0c65db5",
+				Capacity: int64(10),
+				Status:   models.StatusType("available"),
+			},
+			updatedVolume: &models.Volume{
+				ID:       "16f293bf-test-4bff-816f-e199c0c65db5",
+				Capacity: int64(20),
+				Status:   models.StatusType("available"),
+			},
+			verify: func(t *testing.T, err error) {
+				assert.Nil(t, err)
+				assert.Equal(t, 1, volumeService.PatchVolumeCallCount())
+				volumeID, volumePatch, _ := volumeService.PatchVolumeArgsForCall(0)
+				assert.Equal(t, "16f293bf-test-4bff-816f-e199c0c65db5", volumeID)
+				assert.Equal(t, int64(20), volumePatch.Capacity)
+			},
+		}, {
+			testCaseName: "Wrong volume ID",
+			providerVolume: provider.Volume{
+				VolumeID: "wrong-volume-id",
+				Capacity: Int(20),
+			},
+			expectedErr: "InvalidVolumeID",
+		}, {
+			testCaseName: "Volume capacity is empty",
+			providerVolume: provider.Volume{
+				VolumeID: "16f293bf-test-4bff-816f-e199c0c65db5",
+			},
+			expectedErr: "VolumeCapacityInvalid",
+		}, {
+			testCaseName: "Volume capacity is beyond the profile limit",
+			providerVolume: provider.Volume{
+				VolumeID: "16f293bf-test-4bff-816f-e199c0c65db5",
+				Capacity: Int(20000),
+			},
+			expectedErr: "VolumeCapacityInvalid",
+		}, {
+			testCaseName: "Volume capacity is beyond the limit of the volume profile",
+			providerVolume: provider.Volume{
+				VolumeID: "16f293bf-test-4bff-816f-e199c0c65db5",
+				Capacity: Int(1500),
+			},
+			baseVolume: &models.Volume{
+				ID:       "16f293bf-test-4bff-816f-e199c0c65db5",
+				Capacity: int64(10),
+				Status:   models.StatusType("available"),
+				Profile:  &models.Profile{Name: "test-profile"},
+			},
+			profile: &models.VolumeProfile{
+				Name:     "test-profile",
+				Capacity: &models.ProfileRange{Type: models.ProfileRangeTypeRange, Min: 10, Max: 1000},
+			},
+			expectedErr: "VolumeProfileCapacityInvalid",
+			verify: func(t *testing.T, err error) {
+				assert.Equal(t, 0, volumeService.PatchVolumeCallCount())
+			},
+		}, {
+			testCaseName: "Volume shrink is not allowed",
+			providerVolume: provider.Volume{
+				VolumeID: "16f293bf-test-4bff-816f-e199c0c65db5",
+				Capacity: Int(10),
+			},
+			baseVolume: &models.Volume{
+				ID:       "16f293bf-test-4bff-816f-e199c0c65db5",
+				Capacity: int64(20),
+				Status:   models.StatusType("available"),
+			},
+			expectedErr: "VolumeCapacityShrinkInvalid",
+			verify: func(t *testing.T, err error) {
+				assert.Equal(t, 0, volumeService.PatchVolumeCallCount())
+			},
+		}, {
+			testCaseName: "Volume expansion failed",
+			providerVolume: provider.Volume{
+				VolumeID: "16f293bf-test-4bff-816f-e199c0c65db5",
+				Capacity: Int(1500),
+			},
+			baseVolume: &models.Volume{
+				ID:       "16f293bf-test-4bff-816f-e199c0c65db5",
+				Capacity: int64(10),
+				Status:   models.StatusType("available"),
+			},
+			patchErr:    &models.Error{Errors: []models.ErrorItem{{Code: "volume_capacity_max", Message: "capacity is beyond the maximum"}}},
+			expectedErr: "FailedToExpandVolume",
+		},
+	}
+
+	for _, testcase := range testCases {
+		t.Run(testcase.testCaseName, func(t *testing.T) {
+			vpcs, uc, sc, err := GetTestOpenSession(t, logger)
+			assert.NotNil(t, vpcs)
+			assert.NotNil(t, uc)
+			assert.NotNil(t, sc)
+			assert.Nil(t, err)
+
+			volumeService = &volumeServiceFakes.VolumeService{}
+			assert.NotNil(t, volumeService)
+			uc.VolumeServiceReturns(volumeService)
+
+			if testcase.profile != nil {
+				profileService := &volumeServiceFakes.ProfileService{}
+				profileService.GetVolumeProfileReturns(testcase.profile, nil)
+				uc.ProfileServiceReturns(profileService)
+			}
+
+			volumeService.GetVolumeReturnsOnCall(0, testcase.baseVolume, nil)
+			volumeService.GetVolumeReturns(testcase.updatedVolume, nil)
+			volumeService.PatchVolumeReturns(testcase.updatedVolume, testcase.patchErr)
+
+			err = vpcs.UpdateVolume(testcase.providerVolume)
+
+			if testcase.expectedErr != "" {
+				assert.NotNil(t, err)
+				logger.Info("Error details", zap.Reflect("Error details", err.Error()))
+				assert.Contains(t, err.Error(), testcase.expectedErr)
+			}
+
+			if testcase.verify != nil {
+				testcase.verify(t, err)
+			}
+		})
+	}
+}
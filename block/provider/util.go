@@ -18,6 +18,11 @@
 package provider
 
 import (
+	"context"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -27,14 +32,14 @@ import (
 	"go.uber.org/zap"
 )
 
-// maxRetryAttempt ...
-var maxRetryAttempt = 10
-
-// maxRetryGap ...
-var maxRetryGap = 60
-
-// retryGap ...
-var retryGap = 10
+// Default values of the retry policy
+const (
+	defaultMaxRetryAttempt = 10
+	defaultMaxRetryGap     = 60 // seconds
+	defaultRetryGap        = 10 // seconds
+	defaultRetryMultiplier = 2
+	defaultRetryJitter     = 0.1 // fraction of the wait, so that the sessions failing together do not retry together
+)
 
 //ConstantRetryGap ...
 const (
@@ -52,6 +57,7 @@ var skipErrorCodes = map[string]bool{
 	"not_found":                        true,
 	"volume_id_not_found":              true,
 	"volume_name_not_found":            true,
+	"snapshot_not_found":               true,
 	"internal_error":                   false,
 	"invalid_route":                    false,
 
@@ -66,13 +72,20 @@ var skipErrorCodes = map[string]bool{
 	"P4109":  true, // Volume attachment not found
 }
 
-// retry ...
-func retry(logger *zap.Logger, retryfunc func() error) error {
+// Retry calls the function till it succeeds, fails with an error which can not be retried or the attempts are exhausted.
+// It stops as soon as the context is done, the context error is returned then
+func (policy RetryPolicy) Retry(ctx context.Context, logger *zap.Logger, retryfunc func() error) error {
 	var err error
+	gap := policy.InitialGap
 
-	for i := 0; i < maxRetryAttempt; i++ {
+	for i := 0; i < policy.MaxAttempts; i++ {
 		if i > 0 {
-			time.Sleep(time.Duration(retryGap) * time.Second)
+			if ctxErr := sleepWithContext(ctx, policy.waitBeforeRetry(logger, policy.withJitter(gap), err)); ctxErr != nil {
+				logger.Warn("Retry stopped as the context is done", zap.Error(ctxErr), zap.NamedError("lastError", err))
+				return ctxErr
+			}
+		} else if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
 		}
 		err = retryfunc()
 		if err != nil {
@@ -85,13 +98,10 @@ func retry(logger *zap.Logger, retryfunc func() error) error {
 				break
 			}
 			if i >= 1 {
-				retryGap = 2 * retryGap
-				if retryGap > maxRetryGap {
-					retryGap = maxRetryGap
-				}
+				gap = policy.nextGap(gap)
 			}
-			if (i + 1) < maxRetryAttempt {
-				logger.Info("Error while executing the function. Re-attempting execution ..", zap.Int("attempt..", i+2), zap.Int("retry-gap", retryGap), zap.Int("max-retry-Attempts", maxRetryAttempt), zap.Error(err))
+			if (i + 1) < policy.MaxAttempts {
+				logger.Info("Error while executing the function. Re-attempting execution ..", zap.Int("attempt..", i+2), zap.Duration("retry-gap", gap), zap.Int("max-retry-Attempts", policy.MaxAttempts), zap.Error(err))
 			}
 			continue
 		}
@@ -100,7 +110,21 @@ func retry(logger *zap.Logger, retryfunc func() error) error {
 	return err
 }
 
-// skipRetry skip retry as per listed error codes
+// retryableClientStatusCodes are the 4xx HTTP status codes which may succeed on retry
+var retryableClientStatusCodes = map[int]bool{
+	http.StatusUnauthorized:    true,
+	http.StatusRequestTimeout:  true,
+	http.StatusConflict:        true, // resource is in transition
+	http.StatusTooManyRequests: true,
+}
+
+// retryAfterStatusCodes are the HTTP status codes for which the Retry-After header of the server is honored
+var retryAfterStatusCodes = map[int]bool{
+	http.StatusTooManyRequests:    true,
+	http.StatusServiceUnavailable: true,
+}
+
+// skipRetry skip retry as per listed error codes, the validation errors are not retried by default
 func skipRetry(err *models.Error) bool {
 	for _, errorItem := range err.Errors {
 		skipStatus, ok := skipErrorCodes[string(errorItem.Code)]
@@ -108,10 +132,10 @@ func skipRetry(err *models.Error) bool {
 			return skipStatus
 		}
 	}
-	return false
+	return isValidationErrorStatus(err.StatusCode)
 }
 
-// SkipRetryForIKS skip retry as per listed error codes
+// SkipRetryForIKS skip retry as per listed error codes, the validation errors are not retried by default
 func SkipRetryForIKS(err error) bool {
 	iksError, iksok := err.(*models.IksError)
 	if iksok {
@@ -119,10 +143,45 @@ func SkipRetryForIKS(err error) bool {
 		if ok {
 			return skipStatus
 		}
+		return isValidationErrorStatus(iksError.StatusCode)
 	}
 	return false
 }
 
+// isValidationErrorStatus tells if the HTTP status code is of a client error which does not succeed on retry
+func isValidationErrorStatus(statusCode int) bool {
+	return statusCode >= http.StatusBadRequest && statusCode < http.StatusInternalServerError && !retryableClientStatusCodes[statusCode]
+}
+
+// retryAfter returns the wait requested by the server for the throttled and unavailable responses, 0 otherwise
+func retryAfter(err error) time.Duration {
+	switch backendErr := err.(type) {
+	case *models.Error:
+		if retryAfterStatusCodes[backendErr.StatusCode] {
+			return backendErr.RetryAfter
+		}
+	case *models.IksError:
+		if retryAfterStatusCodes[backendErr.StatusCode] {
+			return backendErr.RetryAfter
+		}
+	}
+	return 0
+}
+
+// waitBeforeRetry returns the wait requested by the server for the last error if any, else the given gap.
+// The wait requested by the server is limited to the maximum gap of the policy
+func (policy RetryPolicy) waitBeforeRetry(logger *zap.Logger, gap time.Duration, lastErr error) time.Duration {
+	if serverWait := retryAfter(lastErr); serverWait > 0 {
+		if policy.MaxGap > 0 && serverWait > policy.MaxGap {
+			logger.Info("Retry-After of the server exceeds the maximum gap, waiting for the maximum gap", zap.Duration("retry-after", serverWait), zap.Duration("max-gap", policy.MaxGap))
+			serverWait = policy.MaxGap
+		}
+		logger.Info("Waiting as requested by the server through Retry-After", zap.Duration("retry-after", serverWait), zap.Error(lastErr))
+		return serverWait
+	}
+	return gap
+}
+
 // skipRetryForObviousErrors skip retry as per listed error codes
 func skipRetryForObviousErrors(err error, isIKS bool) bool {
 	// Only for storage-api ms related calls error
@@ -138,36 +197,62 @@ func skipRetryForObviousErrors(err error, isIKS bool) bool {
 	return false
 }
 
-// FlexyRetry ...
-type FlexyRetry struct {
-	maxRetryAttempt int
-	maxRetryGap     int
+// RetryPolicy holds the retry parameters of a session. The policy is never modified by the retries,
+// the backoff state lives in each call so that concurrent calls and sessions do not affect each other
+type RetryPolicy struct {
+	InitialGap  time.Duration // wait before the second attempt
+	Multiplier  float64       // growth of the wait after each failed attempt
+	MaxGap      time.Duration // upper limit of the wait between two attempts
+	MaxAttempts int           // number of attempts
+	Jitter      float64       // fraction of the wait which is added randomly, 0 for none
+}
+
+// NewFlexyRetryDefault returns the default retry policy
+func NewFlexyRetryDefault() RetryPolicy {
+	return NewFlexyRetry(defaultMaxRetryAttempt, defaultMaxRetryGap)
 }
 
-// NewFlexyRetryDefault ...
-func NewFlexyRetryDefault() FlexyRetry {
-	return FlexyRetry{
-		// Default values as we configuration
-		maxRetryAttempt: maxRetryAttempt,
-		maxRetryGap:     maxRetryGap,
+// NewFlexyRetry returns the retry policy with the given attempts and maximum gap in seconds
+func NewFlexyRetry(maxRtyAtmpt int, maxrRtyGap int) RetryPolicy {
+	return RetryPolicy{
+		InitialGap:  time.Duration(defaultRetryGap) * time.Second,
+		Multiplier:  defaultRetryMultiplier,
+		MaxGap:      time.Duration(maxrRtyGap) * time.Second,
+		MaxAttempts: maxRtyAtmpt,
+		Jitter:      defaultRetryJitter,
 	}
 }
 
-// NewFlexyRetry ...
-func NewFlexyRetry(maxRtyAtmpt int, maxrRtyGap int) FlexyRetry {
-	return FlexyRetry{
-		maxRetryAttempt: maxRtyAtmpt,
-		maxRetryGap:     maxrRtyGap,
+// nextGap returns the wait after the given one as per the multiplier, limited to the maximum gap
+func (policy RetryPolicy) nextGap(gap time.Duration) time.Duration {
+	gap = time.Duration(float64(gap) * policy.Multiplier)
+	if gap > policy.MaxGap {
+		gap = policy.MaxGap
 	}
+	return gap
 }
 
-// FlexyRetry ...
-func (fRetry *FlexyRetry) FlexyRetry(logger *zap.Logger, funcToRetry func() (error, bool)) error {
+// withJitter adds the random part to the wait
+func (policy RetryPolicy) withJitter(gap time.Duration) time.Duration {
+	if policy.Jitter <= 0 || gap <= 0 {
+		return gap
+	}
+	return gap + time.Duration(rand.Float64()*policy.Jitter*float64(gap)) // #nosec G404 not used for security
+}
+
+// FlexyRetry ... It stops as soon as the context is done, the context error is returned then
+func (policy RetryPolicy) FlexyRetry(ctx context.Context, logger *zap.Logger, funcToRetry func() (error, bool)) error {
 	var err error
 	var stopRetry bool
-	for i := 0; i < fRetry.maxRetryAttempt; i++ {
+	gap := policy.InitialGap
+	for i := 0; i < policy.MaxAttempts; i++ {
 		if i > 0 {
-			time.Sleep(time.Duration(retryGap) * time.Second)
+			if ctxErr := sleepWithContext(ctx, policy.waitBeforeRetry(logger, policy.withJitter(gap), err)); ctxErr != nil {
+				logger.Warn("FlexyRetry stopped as the context is done", zap.Error(ctxErr), zap.NamedError("lastError", err))
+				return ctxErr
+			}
+		} else if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
 		}
 		// Call function which required retry, retry is decided by function itself
 		err, stopRetry = funcToRetry()
@@ -177,29 +262,31 @@ func (fRetry *FlexyRetry) FlexyRetry(logger *zap.Logger, funcToRetry func() (err
 
 		// Update retry gap as per exponentioal
 		if i >= 1 {
-			retryGap = 2 * retryGap
-			if retryGap > fRetry.maxRetryGap {
-				retryGap = fRetry.maxRetryGap
-			}
+			gap = policy.nextGap(gap)
 		}
-		if (i + 1) < fRetry.maxRetryAttempt {
+		if (i + 1) < policy.MaxAttempts {
 			logger.Info("UNEXPECTED RESULT, Re-attempting execution ..", zap.Int("attempt..", i+2),
-				zap.Int("retry-gap", retryGap), zap.Int("max-retry-Attempts", fRetry.maxRetryAttempt),
+				zap.Duration("retry-gap", gap), zap.Int("max-retry-Attempts", policy.MaxAttempts),
 				zap.Bool("stopRetry", stopRetry), zap.Error(err))
 		}
 	}
 	return err
 }
 
-// FlexyRetryWithConstGap ...
-func (fRetry *FlexyRetry) FlexyRetryWithConstGap(logger *zap.Logger, funcToRetry func() (error, bool)) error {
+// FlexyRetryWithConstGap ... It stops as soon as the context is done, the context error is returned then
+func (policy RetryPolicy) FlexyRetryWithConstGap(ctx context.Context, logger *zap.Logger, funcToRetry func() (error, bool)) error {
 	var err error
 	var stopRetry bool
 	// lets have more number of try for wait for attach and detach specially
-	totalAttempt := fRetry.maxRetryAttempt * 4 // 40 time as per default values i.e 400 seconds
+	totalAttempt := policy.MaxAttempts * 4 // 40 time as per default values i.e 400 seconds
 	for i := 0; i < totalAttempt; i++ {
 		if i > 0 {
-			time.Sleep(time.Duration(ConstantRetryGap) * time.Second)
+			if ctxErr := sleepWithContext(ctx, policy.waitBeforeRetry(logger, time.Duration(ConstantRetryGap)*time.Second, err)); ctxErr != nil {
+				logger.Warn("FlexyRetryWithConstGap stopped as the context is done", zap.Error(ctxErr), zap.NamedError("lastError", err))
+				return ctxErr
+			}
+		} else if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
 		}
 		// Call function which required retry, retry is decided by function itself
 		err, stopRetry = funcToRetry()
@@ -216,6 +303,18 @@ func (fRetry *FlexyRetry) FlexyRetryWithConstGap(logger *zap.Logger, funcToRetry
 	return err
 }
 
+// sleepWithContext waits for the duration, it returns the context error as soon as the context is done
+func sleepWithContext(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
 // ToInt ...
 func ToInt(valueInInt string) int {
 	value, err := strconv.Atoi(valueInInt)
@@ -244,32 +343,15 @@ func FromProviderToLibVolume(vpcVolume *models.Volume, logger *zap.Logger) (libV
 		return
 	}
 
-	if vpcVolume.Zone == nil {
-		logger.Info("Volume zone is empty")
-		return
-	}
-
 	logger.Debug("Volume details of VPC client", zap.Reflect("models.Volume", vpcVolume))
-
-	volumeCap := int(vpcVolume.Capacity)
-	iops := strconv.Itoa(int(vpcVolume.Iops))
-	var createdDate time.Time
-	if vpcVolume.CreatedAt != nil {
-		createdDate = *vpcVolume.CreatedAt
+	if vpcVolume.Zone == nil {
+		logger.Info("Volume zone is empty", zap.Reflect("VolumeID", vpcVolume.ID))
 	}
 
-	libVolume = &provider.Volume{
-		VolumeID:     vpcVolume.ID,
-		Provider:     VPC,
-		Capacity:     &volumeCap,
-		Iops:         &iops,
-		VolumeType:   VolumeType,
-		CreationTime: createdDate,
-	}
-	if vpcVolume.Zone != nil {
-		libVolume.Az = vpcVolume.Zone.Name
-	}
-	libVolume.CRN = vpcVolume.CRN
+	volume := models.NewProviderVolume(*vpcVolume)
+	volume.Provider = VPC
+	volume.VolumeType = VolumeType
+	libVolume = &volume
 	return
 }
 
@@ -279,13 +361,77 @@ func IsValidVolumeIDFormat(volID string) bool {
 	return len(parts) >= volumeIDPartsCount
 }
 
-// SetRetryParameters sets the retry logic parameters
-func SetRetryParameters(maxAttempts int, maxGap int) {
+// SetRetryParameters returns the default retry policy with the configured attempts and maximum gap in seconds,
+// the default values are kept for the parameters which are not set
+func SetRetryParameters(maxAttempts int, maxGap int) RetryPolicy {
+	policy := NewFlexyRetryDefault()
 	if maxAttempts > 0 {
-		maxRetryAttempt = maxAttempts
+		policy.MaxAttempts = maxAttempts
 	}
 
 	if maxGap > 0 {
-		maxRetryGap = maxGap
+		policy.MaxGap = time.Duration(maxGap) * time.Second
+	}
+	return policy
+}
+
+// FromProviderToLibSnapshot converting vpc provider snapshot type to generic lib snapshot type
+func FromProviderToLibSnapshot(vpcSnapshot *models.Snapshot, logger *zap.Logger) (libSnapshot *provider.Snapshot) {
+	logger.Debug("Entry of FromProviderToLibSnapshot method...")
+	defer logger.Debug("Exit from FromProviderToLibSnapshot method...")
+
+	if vpcSnapshot == nil {
+		logger.Info("Snapshot details are empty")
+		return
+	}
+
+	logger.Debug("Snapshot details of VPC client", zap.Reflect("models.Snapshot", vpcSnapshot))
+
+	libSnapshot = &provider.Snapshot{
+		SnapshotID: vpcSnapshot.ID,
+	}
+	libSnapshot.Provider = VPC
+	libSnapshot.VolumeType = VolumeType
+	if vpcSnapshot.CreatedAt != nil {
+		libSnapshot.SnapshotCreationTime = *vpcSnapshot.CreatedAt
+	}
+	if vpcSnapshot.Size > 0 {
+		snapshotSize := int(vpcSnapshot.Size)
+		libSnapshot.SnapshotSize = &snapshotSize
+	}
+	// Source volume is only a reference (ID, name and CRN), the rest of the volume details are not returned
+	if vpcSnapshot.SourceVolume != nil {
+		libSnapshot.VolumeID = vpcSnapshot.SourceVolume.ID
+		libSnapshot.Volume.CRN = vpcSnapshot.SourceVolume.CRN
+		if len(vpcSnapshot.SourceVolume.Name) > 0 {
+			volumeName := vpcSnapshot.SourceVolume.Name
+			libSnapshot.Volume.Name = &volumeName
+		}
+	}
+	return
+}
+
+// getStartFromNextHref returns the start token of the next page from the href returned by the list APIs
+func getStartFromNextHref(href string, logger *zap.Logger) string {
+	// "Next":{"href":"https://eu-gb.iaas.cloud.ibm.com/v1/volumes?start=3e898aa7-ac71-4323-952d-a8d741c65a68\u0026limit=1\u0026zone.name=eu-gb-1"}
+	nextURL, err := url.Parse(href)
+	if err != nil {
+		logger.Warn("Next.Href is not a valid URL", zap.Reflect("Next.Href", href), zap.Error(err))
+		return ""
+	}
+	start := nextURL.Query().Get("start")
+	if start == "" {
+		logger.Warn("Next.Href is not in expected format", zap.Reflect("Next.Href", href))
+	}
+	return start
+}
+
+// toTagList converts the tags map to the "key:value" list accepted by the VPC APIs, sorted for a stable order
+func toTagList(tags map[string]string) []string {
+	tagList := make([]string, 0, len(tags))
+	for key, value := range tags {
+		tagList = append(tagList, key+":"+value)
 	}
+	sort.Strings(tagList)
+	return tagList
 }
@@ -18,11 +18,15 @@
 package provider
 
 import (
+	"context"
 	"errors"
+	"net/http"
 	"os"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/IBM/ibmcloud-volume-interface/lib/provider"
 	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
@@ -30,9 +34,17 @@ import (
 )
 
 func TestSetRetryParameters(t *testing.T) {
-	SetRetryParameters(2, 5)
-	assert.Equal(t, maxRetryAttempt, 2)
-	assert.Equal(t, maxRetryGap, 5)
+	policy := SetRetryParameters(2, 5)
+	assert.Equal(t, policy.MaxAttempts, 2)
+	assert.Equal(t, policy.MaxGap, 5*time.Second)
+	assert.Equal(t, policy.InitialGap, 10*time.Second)
+	assert.Equal(t, policy.Multiplier, float64(2))
+
+	// The default values are kept for the parameters which are not set
+	policy = SetRetryParameters(0, -1)
+	assert.Equal(t, NewFlexyRetryDefault(), policy)
+	assert.Equal(t, policy.MaxAttempts, 10)
+	assert.Equal(t, policy.MaxGap, 60*time.Second)
 }
 
 func GetTestContextLogger() (*zap.Logger, zap.AtomicLevel) {
@@ -58,10 +70,10 @@ func GetTestContextLogger() (*zap.Logger, zap.AtomicLevel) {
 func TestRetry(t *testing.T) {
 	// Setup new style zap logger
 	logger, _ := GetTestContextLogger()
-	SetRetryParameters(2, 5)
+	policy := SetRetryParameters(2, 5)
 	var err error
 	var attempt int
-	err = retry(logger, func() error {
+	err = policy.Retry(context.Background(), logger, func() error {
 		logger.Info("Testing retry with successful attempt")
 		if attempt == 2 {
 			err = nil
@@ -78,7 +90,7 @@ func TestRetry(t *testing.T) {
 		return err
 	})
 
-	err = retry(logger, func() error {
+	err = policy.Retry(context.Background(), logger, func() error {
 		logger.Info("Testing retry with unsuccessful attempt")
 		errCode := models.ErrorCode("wrong_code")
 		errItem := models.ErrorItem{
@@ -118,20 +130,258 @@ func TestSkipRetry(t *testing.T) {
 	assert.Equal(t, skip, false)
 }
 
+func TestSkipRetryForStatusCode(t *testing.T) {
+	testCases := []struct {
+		name       string
+		code       string
+		statusCode int
+		skip       bool
+	}{
+		{name: "validation error", statusCode: http.StatusBadRequest, skip: true},
+		{name: "unprocessable request", statusCode: http.StatusUnprocessableEntity, skip: true},
+		{name: "throttled request", statusCode: http.StatusTooManyRequests, skip: false},
+		{name: "conflict", statusCode: http.StatusConflict, skip: false},
+		{name: "service unavailable", statusCode: http.StatusServiceUnavailable, skip: false},
+		{name: "no status code", skip: false},
+		{name: "error code takes precedence", code: "internal_error", statusCode: http.StatusBadRequest, skip: false},
+	}
+
+	for _, testcase := range testCases {
+		t.Run(testcase.name, func(t *testing.T) {
+			err := &models.Error{Errors: []models.ErrorItem{{Code: models.ErrorCode(testcase.code)}}, StatusCode: testcase.statusCode}
+			assert.Equal(t, testcase.skip, skipRetry(err))
+
+			iksErr := &models.IksError{Code: testcase.code, StatusCode: testcase.statusCode}
+			assert.Equal(t, testcase.skip, SkipRetryForIKS(iksErr))
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	logger, _ := GetTestContextLogger()
+	// The gap of the policy is too long for the test, the server wait is used instead
+	policy := RetryPolicy{InitialGap: time.Hour, Multiplier: 2, MaxGap: time.Hour, MaxAttempts: 3}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	throttledErr := &models.Error{Errors: []models.ErrorItem{{Code: "too_many_requests"}}, StatusCode: http.StatusTooManyRequests, RetryAfter: 10 * time.Millisecond}
+	attempt := 0
+	err := policy.Retry(ctx, logger, func() error {
+		attempt++
+		if attempt < 3 {
+			return throttledErr
+		}
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, attempt)
+
+	unavailableErr := &models.IksError{Code: "ST0001", StatusCode: http.StatusServiceUnavailable, RetryAfter: 10 * time.Millisecond}
+	attempt = 0
+	err = policy.FlexyRetry(ctx, logger, func() (error, bool) {
+		attempt++
+		return unavailableErr, attempt == 2
+	})
+	assert.Equal(t, unavailableErr, err)
+	assert.Equal(t, 2, attempt)
+
+	attempt = 0
+	err = policy.FlexyRetryWithConstGap(ctx, logger, func() (error, bool) {
+		attempt++
+		return throttledErr, attempt == 2
+	})
+	assert.Equal(t, throttledErr, err)
+	assert.Equal(t, 2, attempt)
+
+	// The wait requested by the server is limited to the maximum gap
+	shortPolicy := RetryPolicy{InitialGap: time.Millisecond, Multiplier: 2, MaxGap: 10 * time.Millisecond, MaxAttempts: 2}
+	longThrottledErr := &models.Error{Errors: []models.ErrorItem{{Code: "too_many_requests"}}, StatusCode: http.StatusTooManyRequests, RetryAfter: time.Hour}
+	assert.Equal(t, 10*time.Millisecond, shortPolicy.waitBeforeRetry(logger, time.Millisecond, longThrottledErr))
+	assert.Equal(t, 10*time.Millisecond, shortPolicy.waitBeforeRetry(logger, time.Millisecond, throttledErr))
+	assert.Equal(t, time.Millisecond, shortPolicy.waitBeforeRetry(logger, time.Millisecond, errors.New("backend error")))
+	attempt = 0
+	err = shortPolicy.Retry(ctx, logger, func() error {
+		attempt++
+		return longThrottledErr
+	})
+	assert.Equal(t, longThrottledErr, err)
+	assert.Equal(t, 2, attempt)
+
+	// Retry-After is honored only for the throttled and unavailable responses
+	assert.Equal(t, time.Duration(0), retryAfter(&models.Error{StatusCode: http.StatusInternalServerError, RetryAfter: time.Second}))
+	assert.Equal(t, time.Duration(0), retryAfter(errors.New("backend error")))
+
+	// A validation error is not retried
+	attempt = 0
+	err = policy.Retry(ctx, logger, func() error {
+		attempt++
+		return &models.Error{StatusCode: http.StatusBadRequest}
+	})
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, attempt)
+}
+
 func TestRetryWithError(t *testing.T) {
-	maxRetryAttempt = 2
-	maxRetryGap = 20
+	policy := NewFlexyRetry(2, 20)
 
 	// Setup new style zap logger
 	logger, _ := GetTestContextLogger()
 	var err error
-	err = retry(logger, func() error {
+	err = policy.Retry(context.Background(), logger, func() error {
 		logger.Info("Testing retry with error")
 		err = errors.New("trace Code:, testerr Please check ")
 		return err
 	})
 }
 
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{InitialGap: time.Second, Multiplier: 2, MaxGap: 5 * time.Second, MaxAttempts: 5}
+
+	gap := policy.InitialGap
+	for _, expectedGap := range []time.Duration{2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second} {
+		gap = policy.nextGap(gap)
+		assert.Equal(t, expectedGap, gap)
+	}
+	// The backoff state is not kept in the policy
+	assert.Equal(t, time.Second, policy.InitialGap)
+
+	// No jitter without Jitter
+	assert.Equal(t, 10*time.Second, policy.withJitter(10*time.Second))
+
+	policy.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		jitteredGap := policy.withJitter(10 * time.Second)
+		assert.True(t, jitteredGap >= 10*time.Second)
+		assert.True(t, jitteredGap < 15*time.Second)
+	}
+	assert.Equal(t, time.Duration(0), policy.withJitter(0))
+
+	// The jitter is set by default, whatever the configured retry parameters
+	assert.Equal(t, defaultRetryJitter, NewFlexyRetryDefault().Jitter)
+	assert.Equal(t, defaultRetryJitter, NewFlexyRetry(2, 20).Jitter)
+	assert.Equal(t, defaultRetryJitter, SetRetryParameters(5, 10).Jitter)
+}
+
+func TestRetryPolicyConcurrentSessions(t *testing.T) {
+	logger, _ := GetTestContextLogger()
+	backendErr := &models.Error{Errors: []models.ErrorItem{{Code: models.ErrorCode("wrong_code")}}}
+
+	sessions := make([]*VPCSession, 10)
+	attempts := make([]int, len(sessions))
+	flexyAttempts := make([]int, len(sessions))
+	for i := range sessions {
+		sessions[i] = &VPCSession{
+			Logger: logger,
+			APIRetry: RetryPolicy{
+				InitialGap:  time.Duration(i+1) * time.Millisecond,
+				Multiplier:  2,
+				MaxGap:      5 * time.Millisecond,
+				MaxAttempts: 3 + i%3,
+				Jitter:      0.1,
+			},
+		}
+	}
+
+	var wg sync.WaitGroup
+	for i, vpcs := range sessions {
+		wg.Add(1)
+		go func(i int, vpcs *VPCSession) {
+			defer wg.Done()
+			_ = vpcs.APIRetry.Retry(vpcs.GetContext(), vpcs.Logger, func() error {
+				attempts[i]++
+				return backendErr
+			})
+			_ = vpcs.APIRetry.FlexyRetry(vpcs.GetContext(), vpcs.Logger, func() (error, bool) {
+				flexyAttempts[i]++
+				return backendErr, false
+			})
+		}(i, vpcs)
+	}
+	wg.Wait()
+
+	for i, vpcs := range sessions {
+		assert.Equal(t, vpcs.APIRetry.MaxAttempts, attempts[i])
+		assert.Equal(t, vpcs.APIRetry.MaxAttempts, flexyAttempts[i])
+		// The policies are not modified by the retries
+		assert.Equal(t, time.Duration(i+1)*time.Millisecond, vpcs.APIRetry.InitialGap)
+	}
+}
+
+func TestRetryWithContext(t *testing.T) {
+	logger, _ := GetTestContextLogger()
+	backendErr := errors.New("trace Code:, testerr Please check ")
+
+	testCases := []struct {
+		name      string
+		retryFunc func(ctx context.Context, funcToRetry func() error) error
+	}{
+		{
+			name: "retry",
+			retryFunc: func(ctx context.Context, funcToRetry func() error) error {
+				return NewFlexyRetry(5, 10).Retry(ctx, logger, funcToRetry)
+			},
+		}, {
+			name: "FlexyRetry",
+			retryFunc: func(ctx context.Context, funcToRetry func() error) error {
+				fRetry := NewFlexyRetry(5, 10)
+				return fRetry.FlexyRetry(ctx, logger, func() (error, bool) {
+					err := funcToRetry()
+					return err, err == nil
+				})
+			},
+		}, {
+			name: "FlexyRetryWithConstGap",
+			retryFunc: func(ctx context.Context, funcToRetry func() error) error {
+				fRetry := NewFlexyRetry(5, 10)
+				return fRetry.FlexyRetryWithConstGap(ctx, logger, func() (error, bool) {
+					err := funcToRetry()
+					return err, err == nil
+				})
+			},
+		},
+	}
+
+	for _, testcase := range testCases {
+		t.Run(testcase.name+" already cancelled", func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			calls := 0
+			err := testcase.retryFunc(ctx, func() error {
+				calls++
+				return backendErr
+			})
+			assert.Equal(t, context.Canceled, err)
+			assert.Equal(t, 0, calls)
+		})
+
+		t.Run(testcase.name+" cancelled while waiting", func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			calls := 0
+			start := time.Now()
+			err := testcase.retryFunc(ctx, func() error {
+				calls++
+				cancel()
+				return backendErr
+			})
+			assert.Equal(t, context.Canceled, err)
+			assert.Equal(t, 1, calls)
+			assert.True(t, time.Since(start) < time.Second)
+		})
+
+		t.Run(testcase.name+" deadline exceeded", func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+			start := time.Now()
+			err := testcase.retryFunc(ctx, func() error {
+				return backendErr
+			})
+			assert.Equal(t, context.DeadlineExceeded, err)
+			assert.True(t, time.Since(start) < time.Second)
+		})
+	}
+}
+
 func TestFromProviderToLibVolume(t *testing.T) {
 	// Setup new style zap logger
 	logger, _ := GetTestContextLogger()
@@ -151,6 +401,189 @@ func TestFromProviderToLibVolume(t *testing.T) {
 	assert.NotNil(t, providerVolume)
 }
 
+func TestFromProviderToLibVolumeMapping(t *testing.T) {
+	// Setup new style zap logger
+	logger, _ := GetTestContextLogger()
+
+	timeNow := time.Now()
+	testCases := []struct {
+		testCaseName string
+		vpcVolume    *models.Volume
+		verify       func(t *testing.T, libVolume *provider.Volume)
+	}{
+		{
+			testCaseName: "Volume is nil",
+			verify: func(t *testing.T, libVolume *provider.Volume) {
+				assert.Nil(t, libVolume)
+			},
+		}, {
+			testCaseName: "Volume without zone",
+			vpcVolume: &models.Volume{
+				ID:       "16f293bf-test-4bff-816f-e199c0c65db5",
+				Capacity: int64(10),
+				Status:   models.StatusType("pending"),
+			},
+			verify: func(t *testing.T, libVolume *provider.Volume) {
+				if assert.NotNil(t, libVolume) {
+					assert.Equal(t, "16f293bf-test-4bff-816f-e199c0c65db5", libVolume.VolumeID)
+					assert.Equal(t, "", libVolume.Az)
+					assert.Nil(t, libVolume.Name)
+					assert.Equal(t, "pending", libVolume.Attributes[models.VolumeStatus])
+				}
+			},
+		}, {
+			testCaseName: "Volume with all details",
+			vpcVolume: &models.Volume{
+				Href:                "https://us-south.iaas.cloud.ibm.com/v1/volumes/16f293bf-test-4bff-816f-e199c0c65db5",
+				ID:                  "16f293bf-test-4bff-816f-e199c0c65db5",
+				Name:                "test-volume",
+				Capacity:            int64(20),
+				Iops:                int64(3000),
+				VolumeEncryptionKey: &models.VolumeEncryptionKey{CRN: "test-encryption-key-crn"},
+				ResourceGroup:       &models.ResourceGroup{ID: "default resource group id", Name: "default"},
+				Tags:                []string{"clusterid:test-cluster"},
+				Profile:             &models.Profile{Name: "custom"},
+				Snapshot:            &models.Snapshot{ID: "s6f293bf-test-4bff-816f-e199c0c65db5"},
+				CreatedAt:           &timeNow,
+				Status:              models.StatusType("available"),
+				VolumeAttachments:   &[]models.VolumeAttachment{{ID: "test-attachment-id", Name: "test-attachment", Type: "data"}},
+				Zone:                &models.Zone{Name: "us-south-1"},
+				CRN:                 "test-volume-crn",
+			},
+			verify: func(t *testing.T, libVolume *provider.Volume) {
+				if assert.NotNil(t, libVolume) {
+					assert.Equal(t, VPC, libVolume.Provider)
+					assert.Equal(t, VolumeType, libVolume.VolumeType)
+					assert.Equal(t, "test-volume", *libVolume.Name)
+					assert.Equal(t, 20, *libVolume.Capacity)
+					assert.Equal(t, "3000", *libVolume.Iops)
+					assert.Equal(t, "us-south-1", libVolume.Az)
+					assert.Equal(t, timeNow, libVolume.CreationTime)
+					assert.Equal(t, "available", libVolume.Attributes[models.VolumeStatus])
+					assert.Equal(t, "s6f293bf-test-4bff-816f-e199c0c65db5", libVolume.Attributes[models.SourceSnapshotID])
+					assert.Equal(t, "custom", libVolume.VPCVolume.Profile.Name)
+					assert.Equal(t, "default resource group id", libVolume.VPCVolume.ResourceGroup.ID)
+					assert.Equal(t, "test-encryption-key-crn", libVolume.VPCVolume.VolumeEncryptionKey.CRN)
+					assert.Equal(t, []string{"clusterid:test-cluster"}, libVolume.VPCVolume.Tags)
+					assert.Equal(t, "test-volume-crn", libVolume.CRN)
+					if assert.NotNil(t, libVolume.VPCVolume.VolumeAttachments) {
+						assert.Equal(t, "test-attachment-id", (*libVolume.VPCVolume.VolumeAttachments)[0].ID)
+					}
+				}
+			},
+		},
+	}
+
+	for _, testcase := range testCases {
+		t.Run(testcase.testCaseName, func(t *testing.T) {
+			testcase.verify(t, FromProviderToLibVolume(testcase.vpcVolume, logger))
+		})
+	}
+}
+
+func TestVolumeMappingRoundTrip(t *testing.T) {
+	// Setup new style zap logger
+	logger, _ := GetTestContextLogger()
+
+	timeNow := time.Now()
+	testCases := []struct {
+		testCaseName string
+		vpcVolume    models.Volume
+	}{
+		{
+			testCaseName: "Minimal volume",
+			vpcVolume: models.Volume{
+				ID:         "16f293bf-test-4bff-816f-e199c0c65db5",
+				Capacity:   int64(10),
+				Zone:       &models.Zone{Name: "us-south-1"},
+				Provider:   string(VPC),
+				VolumeType: string(VolumeType),
+			},
+		}, {
+			testCaseName: "Volume with all details",
+			vpcVolume: models.Volume{
+				Href:                "https://us-south.iaas.cloud.ibm.com/v1/volumes/16f293bf-test-4bff-816f-e199c0c65db5",
+				ID:                  "16f293bf-test-4bff-816f-e199c0c65db5",
+				Name:                "test-volume",
+				Capacity:            int64(20),
+				Iops:                int64(3000),
+				VolumeEncryptionKey: &models.VolumeEncryptionKey{CRN: "test-encryption-key-crn"},
+				ResourceGroup:       &models.ResourceGroup{Href: "test-resource-group-href", ID: "default resource group id", Name: "default"},
+				Tags:                []string{"clusterid:test-cluster", "env:test"},
+				Profile:             &models.Profile{CRN: "test-profile-crn", Href: "test-profile-href", Name: "custom"},
+				Snapshot:            &models.Snapshot{ID: "s6f293bf-test-4bff-816f-e199c0c65db5"},
+				CreatedAt:           &timeNow,
+				Status:              models.StatusType("available"),
+				VolumeAttachments: &[]models.VolumeAttachment{
+					{Href: "test-attachment-href", ID: "test-attachment-id", Name: "test-attachment", Type: "data", DeleteVolumeOnInstanceDelete: true,
+						InstanceID: String("test-instance-id"), Instance: &models.Instance{ID: "test-instance-id"}, Device: &models.Device{ID: "test-device-id"}},
+				},
+				Zone:       &models.Zone{Name: "us-south-1"},
+				CRN:        "test-volume-crn",
+				Cluster:    "test-cluster",
+				Provider:   string(VPC),
+				VolumeType: string(VolumeType),
+			},
+		},
+	}
+
+	for _, testcase := range testCases {
+		t.Run(testcase.testCaseName, func(t *testing.T) {
+			libVolume := FromProviderToLibVolume(&testcase.vpcVolume, logger)
+			assert.NotNil(t, libVolume)
+
+			if testcase.vpcVolume.VolumeAttachments != nil {
+				attachment := (*libVolume.VPCVolume.VolumeAttachments)[0]
+				assert.Equal(t, "test-device-id", attachment.DevicePath)
+				assert.Equal(t, "test-instance-id", libVolume.Attributes[models.AttachmentInstanceIDPrefix+attachment.ID])
+			}
+
+			vpcVolume := models.NewVolume(*libVolume)
+			assert.Equal(t, testcase.vpcVolume, vpcVolume)
+
+			// And back again to the provider volume
+			assert.Equal(t, *libVolume, models.NewProviderVolume(vpcVolume))
+		})
+	}
+}
+
+func TestFromProviderToLibSnapshot(t *testing.T) {
+	// Setup new style zap logger
+	logger, _ := GetTestContextLogger()
+
+	assert.Nil(t, FromProviderToLibSnapshot(nil, logger))
+
+	timeNow := time.Now()
+	vpcSnapshot := &models.Snapshot{
+		ID:           "Test Snapshot ID",
+		Name:         "Test Snapshot",
+		Size:         int64(10),
+		CreatedAt:    &timeNow,
+		SourceVolume: &models.Volume{ID: "Test Volume ID", Name: "Test Volume", CRN: "Test Volume CRN"},
+	}
+	providerSnapshot := FromProviderToLibSnapshot(vpcSnapshot, logger)
+	assert.NotNil(t, providerSnapshot)
+	assert.Equal(t, "Test Snapshot ID", providerSnapshot.SnapshotID)
+	assert.Equal(t, "Test Volume ID", providerSnapshot.VolumeID)
+	assert.Equal(t, "Test Volume", *providerSnapshot.Volume.Name)
+	assert.Equal(t, "Test Volume CRN", providerSnapshot.Volume.CRN)
+	assert.Equal(t, VPC, providerSnapshot.Volume.Provider)
+	assert.Equal(t, 10, *providerSnapshot.SnapshotSize)
+	assert.Equal(t, timeNow, providerSnapshot.SnapshotCreationTime)
+}
+
+func TestGetStartFromNextHref(t *testing.T) {
+	// Setup new style zap logger
+	logger, _ := GetTestContextLogger()
+
+	assert.Equal(t, "3e898aa7-ac71-4323-952d-a8d741c65a68", getStartFromNextHref("https://eu-gb.iaas.cloud.ibm.com/v1/volumes?start=3e898aa7-ac71-4323-952d-a8d741c65a68\u0026limit=1\u0026zone.name=eu-gb-1", logger))
+	assert.Equal(t, "3e898aa7-ac71-4323-952d-a8d741c65a68", getStartFromNextHref("https://eu-gb.iaas.cloud.ibm.com/v1/volumes?limit=1\u0026start=3e898aa7-ac71-4323-952d-a8d741c65a68", logger))
+	assert.Equal(t, "r134-1:2", getStartFromNextHref("https://eu-gb.iaas.cloud.ibm.com/v1/snapshots?start=r134-1%3A2\u0026limit=1", logger))
+	assert.Equal(t, "", getStartFromNextHref("https://eu-gb.iaas.cloud.ibm.com/v1/volumes?restart=3e898aa7\u0026limit=1", logger))
+	assert.Equal(t, "", getStartFromNextHref("https://eu-gb.iaas.cloud.ibm.com/v1/volumes?limit=1", logger))
+	assert.Equal(t, "", getStartFromNextHref("://invalid", logger))
+}
+
 func TestToInt(t *testing.T) {
 	value := ToInt("519")
 	assert.Equal(t, value, 519)
@@ -0,0 +1,74 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package provider ...
+package provider
+
+import (
+	"github.com/IBM/ibmcloud-volume-interface/lib/provider"
+	userError "github.com/IBM/ibmcloud-volume-vpc/common/messages"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/instances"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
+	"go.uber.org/zap"
+)
+
+// validateAttachTarget checks that the instance exists, is running and is in the zone of the volume, so that
+// the attach request fails fast instead of being retried. In IKS the instance ID is the worker ID, the checks
+// are then left to the IKS API. If the details can not be fetched the validation is left to the backend as well.
+// The instance is returned if it could be fetched
+func (vpcs *VPCSession) validateAttachTarget(volumeAttachRequest provider.VolumeAttachmentRequest) (*models.Instance, error) {
+	if vpcs.Config.VPCConfig.IsIKS {
+		return nil, nil
+	}
+
+	var instance *models.Instance
+	var err error
+	err = vpcs.APIRetry.Retry(vpcs.GetContext(), vpcs.Logger, func() error {
+		instance, err = vpcs.Apiclient.WithContext(vpcs.GetContext()).InstanceService().GetInstance(volumeAttachRequest.InstanceID, vpcs.Logger)
+		return err
+	})
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, userError.GetUserError("InstanceNotFound", err, volumeAttachRequest.InstanceID)
+		}
+		vpcs.Logger.Warn("Unable to get the instance details, skipping the attach target validation", zap.Reflect("InstanceID", volumeAttachRequest.InstanceID), zap.Error(err))
+		return nil, nil
+	}
+	if instance == nil {
+		return nil, nil
+	}
+	vpcs.Logger.Info("Validating the attach target", zap.Reflect("Instance", instance))
+
+	if len(instance.Status) > 0 && instance.Status != instances.InstanceStatusRunning {
+		return instance, userError.GetUserError("InstanceNotRunning", nil, volumeAttachRequest.InstanceID, instance.Status)
+	}
+
+	if instance.Zone == nil || len(instance.Zone.Name) == 0 {
+		return instance, nil
+	}
+	var volume *models.Volume
+	err = vpcs.APIRetry.Retry(vpcs.GetContext(), vpcs.Logger, func() error {
+		volume, err = vpcs.Apiclient.WithContext(vpcs.GetContext()).VolumeService().GetVolume(volumeAttachRequest.VolumeID, vpcs.Logger)
+		return err
+	})
+	if err != nil || volume == nil || volume.Zone == nil {
+		vpcs.Logger.Warn("Unable to get the volume zone, skipping the zone check of the attach target", zap.Reflect("VolumeID", volumeAttachRequest.VolumeID), zap.Error(err))
+		return instance, nil
+	}
+	if volume.Zone.Name != instance.Zone.Name {
+		return instance, userError.GetUserError("InstanceZoneMismatch", nil, volumeAttachRequest.InstanceID, instance.Zone.Name, volumeAttachRequest.VolumeID, volume.Zone.Name)
+	}
+	return instance, nil
+}
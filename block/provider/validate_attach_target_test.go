@@ -0,0 +1,146 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package provider ...
+package provider
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/IBM/ibmcloud-volume-interface/lib/provider"
+	instanceFakes "github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/instances/fakes"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
+	volumeServiceFakes "github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/vpcvolume/fakes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateAttachTarget(t *testing.T) {
+	//var err error
+	logger, teardown := GetTestLogger(t)
+	defer teardown()
+
+	notFoundErr := &models.Error{Errors: []models.ErrorItem{{Code: models.ErrorCodeNotFound, Message: "not found"}}}
+
+	testCases := []struct {
+		testCaseName string
+		isIKS        bool
+		instance     *models.Instance
+		instanceErr  error
+		volume       *models.Volume
+		volumeErr    error
+
+		expectedErr             string
+		expectedGetVolumeCalled bool
+	}{
+		{
+			testCaseName:            "Running instance in the zone of the volume",
+			instance:                &models.Instance{ID: "instance-id1", Status: "running", Zone: &models.Zone{Name: "us-south-1"}},
+			volume:                  &models.Volume{ID: "volume-id1", Zone: &models.Zone{Name: "us-south-1"}},
+			expectedGetVolumeCalled: true,
+		}, {
+			testCaseName: "Instance not found",
+			instanceErr:  notFoundErr,
+			expectedErr:  "InstanceNotFound",
+		}, {
+			testCaseName: "Instance not running",
+			instance:     &models.Instance{ID: "instance-id1", Status: "stopped", Zone: &models.Zone{Name: "us-south-1"}},
+			expectedErr:  "InstanceNotRunning",
+		}, {
+			testCaseName:            "Instance in another zone",
+			instance:                &models.Instance{ID: "instance-id1", Status: "running", Zone: &models.Zone{Name: "us-south-2"}},
+			volume:                  &models.Volume{ID: "volume-id1", Zone: &models.Zone{Name: "us-south-1"}},
+			expectedErr:             "InstanceZoneMismatch",
+			expectedGetVolumeCalled: true,
+		}, {
+			testCaseName:            "Volume zone can not be fetched",
+			instance:                &models.Instance{ID: "instance-id1", Status: "running", Zone: &models.Zone{Name: "us-south-2"}},
+			volumeErr:               notFoundErr,
+			expectedGetVolumeCalled: true,
+		}, {
+			testCaseName: "Instance without zone",
+			instance:     &models.Instance{ID: "instance-id1", Status: "running"},
+		}, {
+			testCaseName: "Instance lookup failed",
+			instanceErr:  &models.Error{Errors: []models.ErrorItem{{Code: "validation_invalid_name", Message: "invalid name"}}},
+		}, {
+			testCaseName: "IKS worker is not validated",
+			isIKS:        true,
+			instanceErr:  notFoundErr,
+		},
+	}
+
+	for _, testcase := range testCases {
+		t.Run(testcase.testCaseName, func(t *testing.T) {
+			vpcs, uc, sc, err := GetTestOpenSession(t, logger)
+			assert.NotNil(t, vpcs)
+			assert.NotNil(t, uc)
+			assert.NotNil(t, sc)
+			assert.Nil(t, err)
+
+			vpcs.Config.VPCConfig.IsIKS = testcase.isIKS
+			instanceService := &instanceFakes.InstanceService{}
+			uc.InstanceServiceReturns(instanceService)
+			instanceService.GetInstanceReturns(testcase.instance, testcase.instanceErr)
+			volumeService := &volumeServiceFakes.VolumeService{}
+			uc.VolumeServiceReturns(volumeService)
+			volumeService.GetVolumeReturns(testcase.volume, testcase.volumeErr)
+
+			instance, err := vpcs.validateAttachTarget(provider.VolumeAttachmentRequest{VolumeID: "volume-id1", InstanceID: "instance-id1"})
+			assert.Equal(t, testcase.instance, instance)
+			if testcase.expectedErr != "" {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), testcase.expectedErr)
+				}
+			} else {
+				assert.Nil(t, err)
+			}
+			assert.Equal(t, testcase.expectedGetVolumeCalled, volumeService.GetVolumeCallCount() > 0)
+			if testcase.isIKS {
+				assert.Equal(t, 0, instanceService.GetInstanceCallCount())
+			}
+		})
+	}
+}
+
+func TestAttachVolumeInstanceNotFound(t *testing.T) {
+	//var err error
+	logger, teardown := GetTestLogger(t)
+	defer teardown()
+
+	vpcs, uc, sc, err := GetTestOpenSession(t, logger)
+	assert.NotNil(t, vpcs)
+	assert.NotNil(t, uc)
+	assert.NotNil(t, sc)
+	assert.Nil(t, err)
+
+	vpcs.Config.VPCConfig.IsIKS = false
+	instanceService := &instanceFakes.InstanceService{}
+	uc.InstanceServiceReturns(instanceService)
+	instanceService.GetInstanceReturns(nil, &models.Error{Errors: []models.ErrorItem{{Code: models.ErrorCodeNotFound, Message: "instance not found"}}})
+	volumeAttachService := &instanceFakes.VolumeAttachService{}
+	uc.VolumeAttachServiceReturns(volumeAttachService)
+	volumeAttachService.WithContextReturns(volumeAttachService)
+	vpcs.APIClientVolAttachMgr = volumeAttachService
+	volumeAttachService.AttachVolumeReturns(nil, errors.New("attach must not be called"))
+
+	volumeAttachment, err := vpcs.AttachVolume(provider.VolumeAttachmentRequest{VolumeID: "volume-id1", InstanceID: "instance-id1"})
+	assert.Nil(t, volumeAttachment)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "InstanceNotFound")
+	}
+	// The attach is not attempted, nor retried, for a missing instance
+	assert.Equal(t, 0, volumeAttachService.AttachVolumeCallCount())
+}
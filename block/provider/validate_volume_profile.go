@@ -0,0 +1,84 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package provider ...
+package provider
+
+import (
+	"strconv"
+
+	userError "github.com/IBM/ibmcloud-volume-vpc/common/messages"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
+	"go.uber.org/zap"
+)
+
+// validateVolumeProfile checks the requested capacity and IOPS against the ranges supported by the volume profile.
+// The profiles are cached by the session. If the profile details can not be fetched the validation is left to the backend
+func validateVolumeProfile(vpcs *VPCSession, profileName string, capacity int64, iops int64) error {
+	profile, err := vpcs.Apiclient.WithContext(vpcs.GetContext()).ProfileService().GetVolumeProfile(profileName, vpcs.Logger)
+	if err != nil {
+		if isNotFoundError(err) {
+			return userError.GetUserError("VolumeProfileNotFound", err, profileName)
+		}
+		vpcs.Logger.Warn("Unable to get the volume profile details, skipping the profile validation", zap.Reflect("ProfileName", profileName), zap.Error(err))
+		return nil
+	}
+	if profile == nil {
+		return nil
+	}
+	vpcs.Logger.Debug("Validating request against the volume profile", zap.Reflect("VolumeProfile", profile))
+
+	if minCapacity, maxCapacity, ok := getProfileRange(profile.Capacity); ok && (capacity < minCapacity || (maxCapacity > 0 && capacity > maxCapacity)) {
+		return userError.GetUserError("VolumeProfileCapacityInvalid", nil, capacity, profileName, minCapacity, maxCapacity)
+	}
+
+	if iops <= 0 || profile.Iops == nil {
+		return nil
+	}
+	// IOPS of fixed and dependent profiles are given by the profile (and capacity), they can not be requested
+	if profile.Iops.Type == models.ProfileRangeTypeFixed || profile.Iops.Type == models.ProfileRangeTypeDependent {
+		return userError.GetUserError("VolumeProfileIopsInvalid", nil)
+	}
+	if minIops, maxIops, ok := getProfileRange(profile.Iops); ok && (iops < minIops || (maxIops > 0 && iops > maxIops)) {
+		return userError.GetUserError("IopsInvalid", nil, strconv.FormatInt(iops, 10))
+	}
+	return nil
+}
+
+// getProfileRange returns the bounds of the profile range, ok is false if the range has no usable bounds
+func getProfileRange(profileRange *models.ProfileRange) (min int64, max int64, ok bool) {
+	if profileRange == nil {
+		return 0, 0, false
+	}
+	switch profileRange.Type {
+	case models.ProfileRangeTypeFixed:
+		return profileRange.Value, profileRange.Value, profileRange.Value > 0
+	case models.ProfileRangeTypeRange, models.ProfileRangeTypeDependentRange:
+		return profileRange.Min, profileRange.Max, profileRange.Min > 0 || profileRange.Max > 0
+	}
+	return 0, 0, false
+}
+
+// isNotFoundError checks if the backend error is a not found error
+func isNotFoundError(err error) bool {
+	if modelError, ok := err.(*models.Error); ok {
+		for _, errorItem := range modelError.Errors {
+			if errorItem.Code == models.ErrorCodeNotFound {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,170 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package provider ...
+package provider
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/IBM/ibmcloud-volume-interface/lib/provider"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
+	volumeServiceFakes "github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/vpcvolume/fakes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateVolumeProfile(t *testing.T) {
+	//var err error
+	logger, teardown := GetTestLogger(t)
+	defer teardown()
+
+	tieredProfile := &models.VolumeProfile{
+		Name:     "general-purpose",
+		Capacity: &models.ProfileRange{Type: models.ProfileRangeTypeRange, Min: 10, Max: 16000},
+		Iops:     &models.ProfileRange{Type: models.ProfileRangeTypeDependent},
+	}
+	customProfile := &models.VolumeProfile{
+		Name:     "custom",
+		Capacity: &models.ProfileRange{Type: models.ProfileRangeTypeRange, Min: 10, Max: 16000},
+		Iops:     &models.ProfileRange{Type: models.ProfileRangeTypeDependentRange, Min: 100, Max: 48000},
+	}
+
+	testCases := []struct {
+		testCaseName string
+		profile      *models.VolumeProfile
+		profileErr   error
+		capacity     int64
+		iops         int64
+
+		expectedErr string
+	}{
+		{
+			testCaseName: "Capacity and IOPS in the profile range",
+			profile:      customProfile,
+			capacity:     100,
+			iops:         1000,
+		}, {
+			testCaseName: "Capacity above the profile range",
+			profile:      tieredProfile,
+			capacity:     16001,
+			expectedErr:  "VolumeProfileCapacityInvalid",
+		}, {
+			testCaseName: "Capacity below the profile range",
+			profile:      customProfile,
+			capacity:     5,
+			expectedErr:  "VolumeProfileCapacityInvalid",
+		}, {
+			testCaseName: "Capacity not matching the fixed capacity",
+			profile:      &models.VolumeProfile{Name: "fixed", Capacity: &models.ProfileRange{Type: models.ProfileRangeTypeFixed, Value: 100}},
+			capacity:     200,
+			expectedErr:  "VolumeProfileCapacityInvalid",
+		}, {
+			testCaseName: "IOPS requested for a tiered profile",
+			profile:      tieredProfile,
+			capacity:     100,
+			iops:         1000,
+			expectedErr:  "VolumeProfileIopsInvalid",
+		}, {
+			testCaseName: "IOPS above the profile range",
+			profile:      customProfile,
+			capacity:     100,
+			iops:         50000,
+			expectedErr:  "IopsInvalid",
+		}, {
+			testCaseName: "IOPS below the profile range",
+			profile:      customProfile,
+			capacity:     100,
+			iops:         50,
+			expectedErr:  "IopsInvalid",
+		}, {
+			testCaseName: "Profile without ranges",
+			profile:      &models.VolumeProfile{Name: "general-purpose"},
+			capacity:     100000,
+		}, {
+			testCaseName: "Profile not found",
+			profileErr:   &models.Error{Errors: []models.ErrorItem{{Code: models.ErrorCodeNotFound, Message: "profile not found"}}},
+			capacity:     100,
+			expectedErr:  "VolumeProfileNotFound",
+		}, {
+			testCaseName: "Profile lookup failed",
+			profileErr:   errors.New("connection refused"),
+			capacity:     100,
+		},
+	}
+
+	for _, testcase := range testCases {
+		t.Run(testcase.testCaseName, func(t *testing.T) {
+			vpcs, uc, sc, err := GetTestOpenSession(t, logger)
+			assert.NotNil(t, vpcs)
+			assert.NotNil(t, uc)
+			assert.NotNil(t, sc)
+			assert.Nil(t, err)
+
+			profileService := &volumeServiceFakes.ProfileService{}
+			uc.ProfileServiceReturns(profileService)
+			profileService.GetVolumeProfileReturns(testcase.profile, testcase.profileErr)
+
+			err = validateVolumeProfile(vpcs, "test-profile", testcase.capacity, testcase.iops)
+			if testcase.expectedErr != "" {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), testcase.expectedErr)
+				}
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestCreateVolumeProfileValidation(t *testing.T) {
+	//var err error
+	logger, teardown := GetTestLogger(t)
+	defer teardown()
+
+	vpcs, uc, sc, err := GetTestOpenSession(t, logger)
+	assert.NotNil(t, vpcs)
+	assert.NotNil(t, uc)
+	assert.NotNil(t, sc)
+	assert.Nil(t, err)
+
+	volumeService := &volumeServiceFakes.VolumeService{}
+	uc.VolumeServiceReturns(volumeService)
+	profileService := &volumeServiceFakes.ProfileService{}
+	uc.ProfileServiceReturns(profileService)
+	profileService.GetVolumeProfileReturns(&models.VolumeProfile{
+		Name:     "general-purpose",
+		Capacity: &models.ProfileRange{Type: models.ProfileRangeTypeRange, Min: 10, Max: 16000},
+	}, nil)
+
+	volumeRequest := provider.Volume{
+		Name:     String("test-volume"),
+		Capacity: Int(20000),
+		Az:       "test-zone",
+		VPCVolume: provider.VPCVolume{
+			Profile:       &provider.Profile{Name: "general-purpose"},
+			ResourceGroup: &provider.ResourceGroup{ID: "default resource group id"},
+		},
+	}
+	volume, err := vpcs.CreateVolume(volumeRequest)
+	assert.Nil(t, volume)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "VolumeProfileCapacityInvalid")
+	}
+	profileName, _ := profileService.GetVolumeProfileArgsForCall(0)
+	assert.Equal(t, "general-purpose", profileName)
+	assert.Equal(t, 0, volumeService.CreateVolumeCallCount())
+	assert.Equal(t, 0, volumeService.GetVolumeByNameCallCount())
+}
@@ -39,7 +39,7 @@ func (vpcs *VPCSession) WaitForDetachVolume(volumeAttachmentTemplate provider.Vo
 		return err
 	}
 
-	err = vpcs.APIRetry.FlexyRetryWithConstGap(vpcs.Logger, func() (error, bool) {
+	err = vpcs.APIRetry.FlexyRetryWithConstGap(vpcs.GetContext(), vpcs.Logger, func() (error, bool) {
 		_, err := vpcs.GetVolumeAttachment(volumeAttachmentTemplate)
 		// In case of error we should not retry as there are two conditions for error
 		// 1- some issues at endpoint side --> Which is already covered in vpcs.GetVolumeAttachment
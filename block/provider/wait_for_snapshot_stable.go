@@ -0,0 +1,63 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package provider ...
+package provider
+
+import (
+	"time"
+
+	"github.com/IBM/ibmcloud-volume-interface/lib/metrics"
+	userError "github.com/IBM/ibmcloud-volume-vpc/common/messages"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
+	"go.uber.org/zap"
+)
+
+const (
+	stableSnapshotStatus = "stable"
+	failedSnapshotStatus = "failed"
+)
+
+// WaitForSnapshotStable checks the snapshot for stable status, it stops waiting once the snapshot creation failed
+func WaitForSnapshotStable(vpcs *VPCSession, snapshotID string) (snapshot *models.Snapshot, err error) {
+	vpcs.Logger.Debug("Entry of WaitForSnapshotStable method...")
+	defer vpcs.Logger.Debug("Exit from WaitForSnapshotStable method...")
+	defer metrics.UpdateDurationFromStart(vpcs.Logger, "WaitForSnapshotStable", time.Now())
+
+	vpcs.Logger.Info("Getting snapshot details from VPC provider...", zap.Reflect("SnapshotID", snapshotID))
+
+	err = vpcs.APIRetry.FlexyRetry(vpcs.GetContext(), vpcs.Logger, func() (error, bool) {
+		snapshot, err = vpcs.Apiclient.WithContext(vpcs.GetContext()).SnapshotService().GetSnapshotByID(snapshotID, vpcs.Logger)
+		if err != nil {
+			return err, skipRetryForObviousErrors(err, false)
+		}
+		vpcs.Logger.Info("Getting snapshot details from VPC provider...", zap.Reflect("snapshot", snapshot))
+		if snapshot != nil && snapshot.Status == stableSnapshotStatus {
+			vpcs.Logger.Info("Snapshot got stable state", zap.Reflect("SnapshotDetails", snapshot))
+			return nil, true
+		}
+		// No point in waiting for a snapshot which failed
+		stopRetry := snapshot != nil && snapshot.Status == failedSnapshotStatus
+		return userError.GetUserError("SnapshotNotInStableState", nil, snapshotID), stopRetry
+	})
+
+	if err != nil {
+		vpcs.Logger.Info("Snapshot could not get stable state", zap.Reflect("SnapshotDetails", snapshot))
+		return nil, userError.GetUserError("SnapshotNotInStableState", err, snapshotID)
+	}
+
+	return snapshot, nil
+}
@@ -39,8 +39,8 @@ func WaitForValidVolumeState(vpcs *VPCSession, volumeID string) (err error) {
 	vpcs.Logger.Info("Getting volume details from VPC provider...", zap.Reflect("VolumeID", volumeID))
 
 	var volume *models.Volume
-	err = retry(vpcs.Logger, func() error {
-		volume, err = vpcs.Apiclient.VolumeService().GetVolume(volumeID, vpcs.Logger)
+	err = vpcs.APIRetry.Retry(vpcs.GetContext(), vpcs.Logger, func() error {
+		volume, err = vpcs.Apiclient.WithContext(vpcs.GetContext()).VolumeService().GetVolume(volumeID, vpcs.Logger)
 		if err != nil {
 			return err
 		}
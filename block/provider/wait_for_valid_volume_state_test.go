@@ -87,8 +87,6 @@ func TestWaitForValidVolumeState(t *testing.T) {
 		},
 	}
 
-	SetRetryParameters(2, 10)
-
 	for _, testcase := range testCases {
 		t.Run(testcase.testCaseName, func(t *testing.T) {
 			vpcs, uc, sc, err := GetTestOpenSession(t, logger)
@@ -96,6 +94,7 @@ func TestWaitForValidVolumeState(t *testing.T) {
 			assert.NotNil(t, uc)
 			assert.NotNil(t, sc)
 			assert.Nil(t, err)
+			vpcs.APIRetry = SetRetryParameters(2, 10)
 
 			volumeService = &volumeServiceFakes.VolumeService{}
 			assert.NotNil(t, volumeService)
@@ -0,0 +1,56 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package provider ...
+package provider
+
+import (
+	"time"
+
+	"github.com/IBM/ibmcloud-volume-interface/lib/metrics"
+	userError "github.com/IBM/ibmcloud-volume-vpc/common/messages"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
+	"go.uber.org/zap"
+)
+
+// WaitForVolumeUpdate checks the volume for valid status and waits until isUpdated reports the requested change
+func WaitForVolumeUpdate(vpcs *VPCSession, volumeID string, isUpdated func(volume *models.Volume) bool) (volume *models.Volume, err error) {
+	vpcs.Logger.Debug("Entry of WaitForVolumeUpdate method...")
+	defer vpcs.Logger.Debug("Exit from WaitForVolumeUpdate method...")
+	defer metrics.UpdateDurationFromStart(vpcs.Logger, "WaitForVolumeUpdate", time.Now())
+
+	vpcs.Logger.Info("Getting volume details from VPC provider...", zap.Reflect("VolumeID", volumeID))
+
+	err = vpcs.APIRetry.Retry(vpcs.GetContext(), vpcs.Logger, func() error {
+		volume, err = vpcs.Apiclient.WithContext(vpcs.GetContext()).VolumeService().GetVolume(volumeID, vpcs.Logger)
+		if err != nil {
+			return err
+		}
+		vpcs.Logger.Info("Getting volume details from VPC provider...", zap.Reflect("volume", volume))
+		if volume != nil && volume.Status == validVolumeStatus && (isUpdated == nil || isUpdated(volume)) {
+			vpcs.Logger.Info("Volume got updated and valid (available) state", zap.Reflect("VolumeDetails", volume))
+			return nil
+		}
+		return userError.GetUserError("VolumeNotInValidState", err, volumeID)
+	})
+
+	if err != nil {
+		vpcs.Logger.Info("Volume could not get updated and valid (available) state", zap.Reflect("VolumeDetails", volume))
+		return nil, userError.GetUserError("VolumeNotInValidState", err, volumeID)
+	}
+
+	return volume, nil
+}
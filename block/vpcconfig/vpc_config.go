@@ -18,13 +18,59 @@
 package utils
 
 import (
+	"time"
+
 	"github.com/IBM/ibmcloud-volume-interface/config"
 )
 
+const (
+	// OrphanedVolumeKeep leaves a volume which did not become available as is
+	OrphanedVolumeKeep = ""
+	// OrphanedVolumeDelete deletes a volume which did not become available
+	OrphanedVolumeDelete = "delete"
+	// OrphanedVolumeTag tags a volume which did not become available, for later cleanup
+	OrphanedVolumeTag = "tag"
+)
+
 // VPCBlockConfig ...
 type VPCBlockConfig struct {
 	VPCConfig    *config.VPCProviderConfig
 	IKSConfig    *config.IKSConfig
 	APIConfig    *config.APIConfig
 	ServerConfig *config.ServerConfig
+
+	// OrphanedVolumePolicy decides what CreateVolume does with a volume which was ordered
+	// but did not become available, one of OrphanedVolumeKeep, OrphanedVolumeDelete or OrphanedVolumeTag
+	OrphanedVolumePolicy string
+
+	// ResourceGroupName and G2ResourceGroupName may be configured instead of the resource group IDs
+	// of VPCConfig, the names are resolved to the IDs when the first session is opened
+	ResourceGroupName   string
+	G2ResourceGroupName string
+
+	// MaxVolumeAttachmentsPerProfile is the maximum number of data volumes which can be attached to an
+	// instance of the instance profile, MaxVolumeAttachments applies to the instance profiles not listed.
+	// AttachVolume does not check the attachment limit if no limit applies
+	MaxVolumeAttachmentsPerProfile map[string]int
+	MaxVolumeAttachments           int
+
+	// TrustedProfileID and ComputeResourceTokenFile configure the compute resource token authentication instead of
+	// the API key. The compute resource token read from the file is exchanged for an access token of the trusted profile
+	TrustedProfileID         string
+	ComputeResourceTokenFile string
+
+	// TokenKeyDir is the directory of the private keys which sign the service tokens, the key of a KID is read from
+	// the file named after the KID, the etc directory is used when it is not set. TokenKID is the KID of the active
+	// signer, TokenKIDs lists the other KIDs in rotation, their keys are skipped while their files are missing.
+	// TokenTTL and TokenNotBeforeSkew set the validity of the service tokens, the defaults apply when they are not set
+	TokenKeyDir        string
+	TokenKID           string
+	TokenKIDs          []string
+	TokenTTL           time.Duration
+	TokenNotBeforeSkew time.Duration
+}
+
+// IsComputeResourceAuth tells whether the trusted profile is authenticated with the compute resource token
+func (conf *VPCBlockConfig) IsComputeResourceAuth() bool {
+	return conf.TrustedProfileID != "" && conf.ComputeResourceTokenFile != ""
 }
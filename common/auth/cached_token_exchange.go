@@ -0,0 +1,49 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package auth ...
+package auth
+
+import (
+	"github.com/IBM/ibmcloud-volume-interface/provider/iam"
+	"go.uber.org/zap"
+)
+
+// cachedTokenExchangeService serves the IAM access tokens of the token exchange service from the token cache
+type cachedTokenExchangeService struct {
+	iam.TokenExchangeService
+	endpoint string
+	cache    *TokenCache
+}
+
+// TokenExchangeService ...
+var _ iam.TokenExchangeService = &cachedTokenExchangeService{}
+
+// newCachedTokenExchangeService returns the token exchange service which uses the token cache of the process
+func newCachedTokenExchangeService(tokenExchangeService iam.TokenExchangeService, endpoint string) iam.TokenExchangeService {
+	return &cachedTokenExchangeService{
+		TokenExchangeService: tokenExchangeService,
+		endpoint:             endpoint,
+		cache:                defaultTokenCache,
+	}
+}
+
+// ExchangeIAMAPIKeyForAccessToken returns the cached token of the API key, it is exchanged only when required
+func (tes *cachedTokenExchangeService) ExchangeIAMAPIKeyForAccessToken(iamAPIKey string, logger *zap.Logger) (*iam.AccessToken, error) {
+	return tes.cache.GetAccessToken(iamAPIKey, tes.endpoint, func() (*iam.AccessToken, error) {
+		return tes.TokenExchangeService.ExchangeIAMAPIKeyForAccessToken(iamAPIKey, logger)
+	}, logger)
+}
@@ -32,16 +32,29 @@ func NewVPCContextCredentialsFactory(config *vpcconfig.VPCBlockConfig) (*auth.Co
 		IamClientSecret: config.VPCConfig.IamClientSecret,
 	}
 	ccf, err := auth.NewContextCredentialsFactory(authConfig)
-	if config.VPCConfig.IKSTokenExchangePrivateURL != "" {
+	tokenExchangeURL := config.VPCConfig.TokenExchangeURL
+	if config.IsComputeResourceAuth() {
+		crAuthConfig := &vpciam.ComputeResourceAuthConfiguration{
+			IamURL:           config.VPCConfig.TokenExchangeURL,
+			TrustedProfileID: config.TrustedProfileID,
+			TokenFile:        config.ComputeResourceTokenFile,
+		}
+		ccf.TokenExchangeService, err = vpciam.NewTokenExchangeComputeResourceService(crAuthConfig)
+		// The tokens of the trusted profile are cached apart from the ones of the API key
+		tokenExchangeURL = config.VPCConfig.TokenExchangeURL + "|" + config.TrustedProfileID
+	} else if config.VPCConfig.IKSTokenExchangePrivateURL != "" {
 		authIKSConfig := &vpciam.IksAuthConfiguration{
 			IamAPIKey:       config.VPCConfig.APIKey,
 			PrivateAPIRoute: config.VPCConfig.IKSTokenExchangePrivateURL, // Only for private cluster
 			CSRFToken:       config.APIConfig.PassthroughSecret,          // required for private cluster
 		}
 		ccf.TokenExchangeService, err = vpciam.NewTokenExchangeIKSService(authIKSConfig)
+		tokenExchangeURL = config.VPCConfig.IKSTokenExchangePrivateURL
 	}
 	if err != nil {
 		return nil, err
 	}
+	// The access tokens are shared by all sessions of the process
+	ccf.TokenExchangeService = newCachedTokenExchangeService(ccf.TokenExchangeService, tokenExchangeURL)
 	return ccf, nil
 }
@@ -41,3 +41,26 @@ func TestNewContextCredentialsFactory(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, contextCredentials)
 }
+
+func TestNewContextCredentialsFactoryComputeResource(t *testing.T) {
+	conf := &vpcconfig.VPCBlockConfig{
+		VPCConfig: &config.VPCProviderConfig{
+			Enabled:          true,
+			EndpointURL:      "test-iam-url",
+			TokenExchangeURL: "test-token-exchange-url",
+			VPCTimeout:       "30s",
+		},
+		TrustedProfileID:         "test-profile-id",
+		ComputeResourceTokenFile: "/var/run/secrets/tokens/sa-token",
+	}
+
+	contextCredentials, err := NewVPCContextCredentialsFactory(conf)
+
+	assert.NoError(t, err)
+	if assert.NotNil(t, contextCredentials) {
+		tes, ok := contextCredentials.TokenExchangeService.(*cachedTokenExchangeService)
+		if assert.True(t, ok) {
+			assert.Equal(t, "test-token-exchange-url|test-profile-id", tes.endpoint)
+		}
+	}
+}
@@ -0,0 +1,203 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package auth ...
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/ibmcloud-volume-interface/lib/metrics"
+	"github.com/IBM/ibmcloud-volume-interface/provider/iam"
+	vpciam "github.com/IBM/ibmcloud-volume-vpc/common/iam"
+	"go.uber.org/zap"
+)
+
+// DefaultTokenRefreshAhead is the time before the expiry of the cached token from when a new token is exchanged.
+// The refresh is lazy, the token is exchanged by the first request in that time, not in the background
+const DefaultTokenRefreshAhead = 5 * time.Minute
+
+// Labels of the token cache metrics
+const (
+	tokenCacheHitLabel  = "IAMTokenCacheHit"
+	tokenCacheMissLabel = "IAMTokenCacheMiss"
+	tokenCacheWaitLabel = "IAMTokenCacheWait"
+)
+
+// defaultTokenCache is the token cache of the process, which is shared by all sessions
+var defaultTokenCache = NewTokenCache(DefaultTokenRefreshAhead)
+
+// TokenCacheStats are the counts of the token requests served from the cache, from the token exchange
+// and from the token exchange of a concurrent request
+type TokenCacheStats struct {
+	Hits   uint64
+	Misses uint64
+	Waits  uint64
+}
+
+// TokenCache caches the IAM access tokens by API key and token exchange endpoint until they are about to expire.
+// Only the tokens with known expiry are cached. There is no background refresh: the first request for a token
+// which is about to expire exchanges a new one and waits for it, as do the concurrent requests for the same token
+type TokenCache struct {
+	refreshAhead time.Duration
+
+	mutex     sync.Mutex
+	entries   map[string]*tokenCacheEntry
+	exchanges map[string]*tokenExchangeCall
+
+	hits   uint64
+	misses uint64
+	waits  uint64
+}
+
+// tokenCacheEntry ...
+type tokenCacheEntry struct {
+	token  string
+	expiry time.Time
+}
+
+// tokenExchangeCall is the token exchange in progress, the concurrent requests for the same token wait for it
+type tokenExchangeCall struct {
+	done  chan struct{}
+	token *iam.AccessToken
+	err   error
+}
+
+// NewTokenCache returns an empty token cache
+func NewTokenCache(refreshAhead time.Duration) *TokenCache {
+	return &TokenCache{
+		refreshAhead: refreshAhead,
+		entries:      map[string]*tokenCacheEntry{},
+		exchanges:    map[string]*tokenExchangeCall{},
+	}
+}
+
+// GetAccessToken returns the cached token of the API key and endpoint. A new token is exchanged when there is no
+// cached token or it is about to expire, the concurrent requests for the same token share the exchange
+func (cache *TokenCache) GetAccessToken(apiKey string, endpoint string, exchange func() (*iam.AccessToken, error), logger *zap.Logger) (*iam.AccessToken, error) {
+	key := tokenCacheKey(apiKey, endpoint)
+
+	cache.mutex.Lock()
+	entry, found := cache.entries[key]
+	if found && time.Now().Add(cache.refreshAhead).Before(entry.expiry) {
+		cache.mutex.Unlock()
+		cache.recordHit()
+		logger.Debug("Using the cached IAM access token", zap.String("Endpoint", endpoint), zap.Time("Expiry", entry.expiry))
+		return &iam.AccessToken{Token: entry.token}, nil
+	}
+	if call, inProgress := cache.exchanges[key]; inProgress {
+		cache.mutex.Unlock()
+		cache.recordWait()
+		logger.Debug("Waiting for the IAM access token exchange in progress", zap.String("Endpoint", endpoint))
+		<-call.done
+		return call.result()
+	}
+	call := &tokenExchangeCall{done: make(chan struct{})}
+	cache.exchanges[key] = call
+	cache.mutex.Unlock()
+	cache.recordMiss()
+
+	call.token, call.err = exchange()
+
+	cache.mutex.Lock()
+	delete(cache.exchanges, key)
+	if call.err == nil && call.token != nil {
+		cache.store(key, call.token.Token, logger)
+	} else if found && time.Now().Before(entry.expiry) {
+		// The cached token is still valid, it is of use till a new token can be exchanged
+		logger.Warn("Failed to exchange a new IAM access token, using the cached one", zap.Error(call.err), zap.Time("Expiry", entry.expiry))
+		call.token, call.err = &iam.AccessToken{Token: entry.token}, nil
+	}
+	cache.mutex.Unlock()
+	close(call.done)
+
+	return call.result()
+}
+
+// Invalidate removes the token from the cache, e.g. as it is rejected by the API
+func (cache *TokenCache) Invalidate(token string) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	for key, entry := range cache.entries {
+		if entry.token == token {
+			delete(cache.entries, key)
+		}
+	}
+}
+
+// Stats returns the counts of the token requests served from the cache, from the token exchange
+// and from the token exchange of a concurrent request
+func (cache *TokenCache) Stats() TokenCacheStats {
+	return TokenCacheStats{
+		Hits:   atomic.LoadUint64(&cache.hits),
+		Misses: atomic.LoadUint64(&cache.misses),
+		Waits:  atomic.LoadUint64(&cache.waits),
+	}
+}
+
+// store caches the token if its expiry is known, the caller holds the lock
+func (cache *TokenCache) store(key string, token string, logger *zap.Logger) {
+	claims, err := vpciam.ParseAccessToken(token)
+	if err != nil || claims.Expiry().IsZero() {
+		logger.Debug("IAM access token is not cached as its expiry is unknown", zap.Error(err))
+		delete(cache.entries, key)
+		return
+	}
+	cache.entries[key] = &tokenCacheEntry{token: token, expiry: claims.Expiry()}
+}
+
+func (cache *TokenCache) recordHit() {
+	atomic.AddUint64(&cache.hits, 1)
+	metrics.RegisterFunction(tokenCacheHitLabel)
+}
+
+func (cache *TokenCache) recordMiss() {
+	atomic.AddUint64(&cache.misses, 1)
+	metrics.RegisterFunction(tokenCacheMissLabel)
+}
+
+func (cache *TokenCache) recordWait() {
+	atomic.AddUint64(&cache.waits, 1)
+	metrics.RegisterFunction(tokenCacheWaitLabel)
+}
+
+// result returns a copy of the exchanged token so that the callers do not share it
+func (call *tokenExchangeCall) result() (*iam.AccessToken, error) {
+	if call.err != nil || call.token == nil {
+		return nil, call.err
+	}
+	return &iam.AccessToken{Token: call.token.Token}, nil
+}
+
+// tokenCacheKey is the key of the token, the API key is hashed to not keep it in memory
+func tokenCacheKey(apiKey string, endpoint string) string {
+	hash := sha256.Sum256([]byte(apiKey))
+	return hex.EncodeToString(hash[:]) + "|" + endpoint
+}
+
+// InvalidateAccessToken removes the token from the token cache of the process
+func InvalidateAccessToken(token string) {
+	defaultTokenCache.Invalidate(token)
+}
+
+// GetTokenCacheStats returns the statistics of the token cache of the process
+func GetTokenCacheStats() TokenCacheStats {
+	return defaultTokenCache.Stats()
+}
@@ -0,0 +1,250 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package auth ...
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IBM/ibmcloud-volume-interface/config"
+	"github.com/IBM/ibmcloud-volume-interface/provider/iam"
+	vpcconfig "github.com/IBM/ibmcloud-volume-vpc/block/vpcconfig"
+	"github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func getTestAccessToken(t *testing.T, expiry time.Time) string {
+	claims := jwt.MapClaims{"iam_id": "test-iam-id", "jti": fmt.Sprint(time.Now().UnixNano())}
+	if !expiry.IsZero() {
+		claims["exp"] = expiry.Unix()
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("test-secret"))
+	require.NoError(t, err)
+	return token
+}
+
+func TestTokenCache(t *testing.T) {
+	logger := zap.NewNop()
+	validToken := getTestAccessToken(t, time.Now().Add(time.Hour))
+	expiringToken := getTestAccessToken(t, time.Now().Add(time.Minute))
+
+	testCases := []struct {
+		testCaseName    string
+		tokens          []string
+		exchangeErr     []error
+		expectedTokens  []string
+		expectedErr     []bool
+		expectedCalls   int
+		expectedHits    uint64
+		expectedMisses  uint64
+		secondAPIKey    string
+		secondEndpoint  string
+		invalidateFirst bool
+	}{
+		{
+			testCaseName:   "Token is served from the cache",
+			tokens:         []string{validToken},
+			expectedTokens: []string{validToken, validToken},
+			expectedCalls:  1,
+			expectedHits:   1,
+			expectedMisses: 1,
+		}, {
+			testCaseName:   "Token which is about to expire is exchanged again",
+			tokens:         []string{expiringToken, validToken},
+			expectedTokens: []string{expiringToken, validToken},
+			expectedCalls:  2,
+			expectedMisses: 2,
+		}, {
+			testCaseName:   "Token without expiry is not cached",
+			tokens:         []string{"token-1", "token-2"},
+			expectedTokens: []string{"token-1", "token-2"},
+			expectedCalls:  2,
+			expectedMisses: 2,
+		}, {
+			testCaseName:   "Tokens are cached by API key",
+			tokens:         []string{validToken, expiringToken},
+			expectedTokens: []string{validToken, expiringToken},
+			secondAPIKey:   "other-api-key",
+			expectedCalls:  2,
+			expectedMisses: 2,
+		}, {
+			testCaseName:   "Tokens are cached by endpoint",
+			tokens:         []string{validToken, expiringToken},
+			expectedTokens: []string{validToken, expiringToken},
+			secondEndpoint: "https://other-iam",
+			expectedCalls:  2,
+			expectedMisses: 2,
+		}, {
+			testCaseName:    "Invalidated token is exchanged again",
+			tokens:          []string{validToken, expiringToken},
+			expectedTokens:  []string{validToken, expiringToken},
+			invalidateFirst: true,
+			expectedCalls:   2,
+			expectedMisses:  2,
+		}, {
+			testCaseName:   "Cached token is used while it is valid and can not be exchanged",
+			tokens:         []string{expiringToken, ""},
+			exchangeErr:    []error{nil, errors.New("exchange failed")},
+			expectedTokens: []string{expiringToken, expiringToken},
+			expectedCalls:  2,
+			expectedMisses: 2,
+		}, {
+			testCaseName:   "Exchange error without cached token",
+			tokens:         []string{"", validToken},
+			exchangeErr:    []error{errors.New("exchange failed"), nil},
+			expectedTokens: []string{"", validToken},
+			expectedErr:    []bool{true, false},
+			expectedCalls:  2,
+			expectedMisses: 2,
+		},
+	}
+
+	for _, testcase := range testCases {
+		t.Run(testcase.testCaseName, func(t *testing.T) {
+			cache := NewTokenCache(DefaultTokenRefreshAhead)
+			calls := 0
+			exchange := func() (*iam.AccessToken, error) {
+				call := calls
+				calls++
+				if len(testcase.exchangeErr) > call && testcase.exchangeErr[call] != nil {
+					return nil, testcase.exchangeErr[call]
+				}
+				return &iam.AccessToken{Token: testcase.tokens[call]}, nil
+			}
+
+			for i, expectedToken := range testcase.expectedTokens {
+				apiKey, endpoint := "test-api-key", "https://iam"
+				if i > 0 {
+					if testcase.secondAPIKey != "" {
+						apiKey = testcase.secondAPIKey
+					}
+					if testcase.secondEndpoint != "" {
+						endpoint = testcase.secondEndpoint
+					}
+					if testcase.invalidateFirst {
+						cache.Invalidate(testcase.expectedTokens[0])
+					}
+				}
+				token, err := cache.GetAccessToken(apiKey, endpoint, exchange, logger)
+				if len(testcase.expectedErr) > i && testcase.expectedErr[i] {
+					assert.NotNil(t, err)
+					assert.Nil(t, token)
+					continue
+				}
+				assert.Nil(t, err)
+				if assert.NotNil(t, token) {
+					assert.Equal(t, expectedToken, token.Token)
+				}
+			}
+			assert.Equal(t, testcase.expectedCalls, calls)
+			assert.Equal(t, TokenCacheStats{Hits: testcase.expectedHits, Misses: testcase.expectedMisses}, cache.Stats())
+		})
+	}
+}
+
+func TestTokenCacheSingleFlight(t *testing.T) {
+	logger := zap.NewNop()
+	validToken := getTestAccessToken(t, time.Now().Add(time.Hour))
+	cache := NewTokenCache(DefaultTokenRefreshAhead)
+
+	var calls int
+	var callsMutex sync.Mutex
+	release := make(chan struct{})
+	exchange := func() (*iam.AccessToken, error) {
+		callsMutex.Lock()
+		calls++
+		callsMutex.Unlock()
+		<-release
+		return &iam.AccessToken{Token: validToken}, nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			token, err := cache.GetAccessToken("test-api-key", "https://iam", exchange, logger)
+			assert.Nil(t, err)
+			if assert.NotNil(t, token) {
+				assert.Equal(t, validToken, token.Token)
+			}
+		}()
+	}
+	// Let the requests reach the cache before the exchange completes
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, 1, calls)
+	// The requests which waited for the exchange are not served from the cache
+	assert.Equal(t, TokenCacheStats{Misses: 1, Waits: 9}, cache.Stats())
+}
+
+func TestContextCredentialsFactoryTokenCache(t *testing.T) {
+	logger := zap.NewNop()
+	validToken := getTestAccessToken(t, time.Now().Add(time.Hour))
+
+	exchanges := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/iam/apikey", func(w http.ResponseWriter, r *http.Request) {
+		exchanges++
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"token": "%s"}`, validToken)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	conf := &vpcconfig.VPCBlockConfig{
+		APIConfig: &config.APIConfig{
+			PassthroughSecret: "csrf-token",
+		},
+		VPCConfig: &config.VPCProviderConfig{
+			Enabled:                    true,
+			APIKey:                     "token-cache-api-key",
+			IKSTokenExchangePrivateURL: server.URL,
+		},
+	}
+
+	stats := GetTokenCacheStats()
+	for i := 0; i < 3; i++ {
+		ccf, err := NewVPCContextCredentialsFactory(conf)
+		require.NoError(t, err)
+		contextCredentials, err := ccf.ForIAMAccessToken(conf.VPCConfig.APIKey, logger)
+		assert.NoError(t, err)
+		assert.Equal(t, validToken, contextCredentials.Credential)
+	}
+	// The sessions share the token of the process cache
+	assert.Equal(t, 1, exchanges)
+	assert.Equal(t, stats.Misses+1, GetTokenCacheStats().Misses)
+	assert.Equal(t, stats.Hits+2, GetTokenCacheStats().Hits)
+
+	// A rejected token is exchanged again
+	InvalidateAccessToken(validToken)
+	ccf, err := NewVPCContextCredentialsFactory(conf)
+	require.NoError(t, err)
+	_, err = ccf.ForIAMAccessToken(conf.VPCConfig.APIKey, logger)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, exchanges)
+}
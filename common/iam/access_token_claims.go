@@ -0,0 +1,57 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package iam ...
+package iam
+
+import (
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+// AccessTokenClaims are the claims of the IAM access token which are of use for the provider
+type AccessTokenClaims struct {
+	jwt.StandardClaims
+
+	Account struct {
+		Bss string `json:"bss"`
+	} `json:"account"`
+}
+
+// ParseAccessToken decodes the claims of the access token, the signature is not verified as it is done
+// by the services which accept the token
+func ParseAccessToken(accessToken string) (*AccessTokenClaims, error) {
+	claims := &AccessTokenClaims{}
+	_, _, err := new(jwt.Parser).ParseUnverified(accessToken, claims)
+	if err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// Expiry returns the expiry time of the token, the zero time if the token does not expire
+func (claims *AccessTokenClaims) Expiry() time.Time {
+	if claims.ExpiresAt == 0 {
+		return time.Time{}
+	}
+	return time.Unix(claims.ExpiresAt, 0)
+}
+
+// AccountID returns the ID of the account the token is issued for
+func (claims *AccessTokenClaims) AccountID() string {
+	return claims.Account.Bss
+}
@@ -0,0 +1,52 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package iam ...
+package iam
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAccessToken(t *testing.T) {
+	expiry := time.Now().Add(time.Hour).Unix()
+	accessToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"exp": expiry, "iam_id": "test-id", "account": map[string]string{"bss": "test-account"}}).SignedString([]byte("secret"))
+	assert.Nil(t, err)
+
+	claims, err := ParseAccessToken(accessToken)
+	assert.Nil(t, err)
+	if assert.NotNil(t, claims) {
+		assert.Equal(t, time.Unix(expiry, 0), claims.Expiry())
+		assert.Equal(t, "test-account", claims.AccountID())
+	}
+
+	// A token without expiry
+	accessToken, err = jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"iam_id": "test-id"}).SignedString([]byte("secret"))
+	assert.Nil(t, err)
+	claims, err = ParseAccessToken(accessToken)
+	assert.Nil(t, err)
+	if assert.NotNil(t, claims) {
+		assert.True(t, claims.Expiry().IsZero())
+	}
+
+	claims, err = ParseAccessToken("not a token")
+	assert.NotNil(t, err)
+	assert.Nil(t, claims)
+}
@@ -0,0 +1,210 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package iam ...
+package iam
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/ibm-cloud-cli-sdk/common/rest"
+	"github.com/IBM/ibmcloud-volume-interface/config"
+	util "github.com/IBM/ibmcloud-volume-interface/lib/utils"
+	"github.com/IBM/ibmcloud-volume-interface/provider/iam"
+	"go.uber.org/zap"
+)
+
+// computeResourceTokenGrantType is the grant type of the IAM token request for a compute resource token
+const computeResourceTokenGrantType = "urn:ibm:params:oauth:grant-type:cr-token"
+
+// tokenExchangeComputeResourceService exchanges the compute resource token for an access token of the trusted profile
+type tokenExchangeComputeResourceService struct {
+	crAuthConfig *ComputeResourceAuthConfiguration
+	httpClient   *http.Client
+}
+
+// ComputeResourceAuthConfiguration ...
+type ComputeResourceAuthConfiguration struct {
+	IamURL           string
+	TrustedProfileID string
+	// TokenFile is the path of the compute resource token, the file is read at every exchange as the token is rotated
+	TokenFile string
+}
+
+// TokenExchangeService ...
+var _ iam.TokenExchangeService = &tokenExchangeComputeResourceService{}
+
+// NewTokenExchangeComputeResourceService ...
+func NewTokenExchangeComputeResourceService(crAuthConfig *ComputeResourceAuthConfiguration) (iam.TokenExchangeService, error) {
+	httpClient, err := config.GeneralCAHttpClient()
+	if err != nil {
+		return nil, err
+	}
+	return &tokenExchangeComputeResourceService{
+		crAuthConfig: crAuthConfig,
+		httpClient:   httpClient,
+	}, nil
+}
+
+// tokenExchangeComputeResourceRequest ...
+type tokenExchangeComputeResourceRequest struct {
+	tes          *tokenExchangeComputeResourceService
+	request      *rest.Request
+	client       *rest.Client
+	logger       *zap.Logger
+	errorRetrier *util.ErrorRetrier
+}
+
+// tokenExchangeComputeResourceResponse ...
+type tokenExchangeComputeResourceResponse struct {
+	AccessToken string `json:"access_token"`
+}
+
+// ExchangeRefreshTokenForAccessToken ...
+func (tes *tokenExchangeComputeResourceService) ExchangeRefreshTokenForAccessToken(refreshToken string, logger *zap.Logger) (*iam.AccessToken, error) {
+	return nil, errUnsupportedTokenExchange("refresh token", "compute resource token authentication")
+}
+
+// ExchangeAccessTokenForIMSToken ...
+func (tes *tokenExchangeComputeResourceService) ExchangeAccessTokenForIMSToken(accessToken iam.AccessToken, logger *zap.Logger) (*iam.IMSToken, error) {
+	return nil, errUnsupportedTokenExchange("IMS token", "compute resource token authentication")
+}
+
+// ExchangeIAMAPIKeyForIMSToken ...
+func (tes *tokenExchangeComputeResourceService) ExchangeIAMAPIKeyForIMSToken(iamAPIKey string, logger *zap.Logger) (*iam.IMSToken, error) {
+	return nil, errUnsupportedTokenExchange("IMS token", "compute resource token authentication")
+}
+
+// ExchangeIAMAPIKeyForAccessToken exchanges the compute resource token for an access token of the trusted profile,
+// there is no API key in this authentication mode so the given one is ignored
+func (tes *tokenExchangeComputeResourceService) ExchangeIAMAPIKeyForAccessToken(iamAPIKey string, logger *zap.Logger) (*iam.AccessToken, error) {
+	crToken, err := tes.readComputeResourceToken()
+	if err != nil {
+		logger.Error("Failed to read the compute resource token", zap.String("TokenFile", tes.crAuthConfig.TokenFile), zap.Error(err))
+		return nil, err
+	}
+
+	r := tes.newTokenExchangeRequest(logger)
+	r.request.Field("grant_type", computeResourceTokenGrantType)
+	r.request.Field("cr_token", crToken)
+	r.request.Field("profile_id", tes.crAuthConfig.TrustedProfileID)
+
+	return r.exchangeForAccessToken()
+}
+
+// GetIAMAccountIDFromAccessToken ...
+func (tes *tokenExchangeComputeResourceService) GetIAMAccountIDFromAccessToken(accessToken iam.AccessToken, logger *zap.Logger) (string, error) {
+	claims, err := ParseAccessToken(accessToken.Token)
+	if err != nil {
+		logger.Error("Failed to parse the access token", zap.Error(err))
+		return "", err
+	}
+	return claims.AccountID(), nil
+}
+
+// readComputeResourceToken returns the current compute resource token
+func (tes *tokenExchangeComputeResourceService) readComputeResourceToken() (string, error) {
+	tokenFile := tes.crAuthConfig.TokenFile
+	// #nosec G304 the token file is set by the provider config
+	token, err := ioutil.ReadFile(tokenFile)
+	if err != nil {
+		return "", util.NewError("ErrorUnclassified",
+			"Failed to read the compute resource token file "+tokenFile, err)
+	}
+	crToken := strings.TrimSpace(string(token))
+	if crToken == "" {
+		return "", util.NewError("ErrorUnclassified",
+			"The compute resource token file "+tokenFile+" is empty")
+	}
+	return crToken, nil
+}
+
+// newTokenExchangeRequest ...
+func (tes *tokenExchangeComputeResourceService) newTokenExchangeRequest(logger *zap.Logger) *tokenExchangeComputeResourceRequest {
+	client := rest.NewClient()
+	client.HTTPClient = tes.httpClient
+	retyrInterval, _ := time.ParseDuration("3s")
+	return &tokenExchangeComputeResourceRequest{
+		tes:          tes,
+		request:      rest.PostRequest(fmt.Sprintf("%s/identity/token", tes.crAuthConfig.IamURL)),
+		client:       client,
+		logger:       logger,
+		errorRetrier: util.NewErrorRetrier(40, retyrInterval, logger),
+	}
+}
+
+// exchangeForAccessToken ...
+func (r *tokenExchangeComputeResourceRequest) exchangeForAccessToken() (*iam.AccessToken, error) {
+	var iamResp *tokenExchangeComputeResourceResponse
+	var err error
+	err = r.errorRetrier.ErrorRetry(func() (error, bool) {
+		iamResp, err = r.sendTokenExchangeRequest()
+		return err, !iam.IsConnectionError(err) // Skip retry if its not connection error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &iam.AccessToken{Token: iamResp.AccessToken}, nil
+}
+
+// sendTokenExchangeRequest ...
+func (r *tokenExchangeComputeResourceRequest) sendTokenExchangeRequest() (*tokenExchangeComputeResourceResponse, error) {
+	r.request.Set("Accept", "application/json")
+
+	var successV tokenExchangeComputeResourceResponse
+	var errorV = struct {
+		ErrorMessage string `json:"errorMessage"`
+		ErrorCode    string `json:"errorCode"`
+		ErrorDetails string `json:"errorDetails"`
+	}{}
+
+	r.logger.Info("Sending IAM token exchange request for the trusted profile", zap.String("TrustedProfileID", r.tes.crAuthConfig.TrustedProfileID))
+	resp, err := r.client.Do(r.request, &successV, &errorV)
+	if err != nil {
+		r.logger.Error("IAM token exchange request failed", zap.Reflect("Response", resp), zap.Error(err))
+		return nil,
+			util.NewError("ErrorUnclassified",
+				"IAM token exchange request failed", err)
+	}
+
+	if resp != nil && resp.StatusCode == 200 {
+		r.logger.Debug("IAM token exchange request successful")
+		return &successV, nil
+	}
+	defer resp.Body.Close()
+
+	if errorV.ErrorMessage != "" {
+		r.logger.Error("IAM token exchange request failed with message",
+			zap.Int("StatusCode", resp.StatusCode), zap.Reflect("Error", errorV))
+
+		err := util.NewError("ErrorFailedTokenExchange",
+			"IAM token exchange request failed: "+errorV.ErrorMessage,
+			errors.New(errorV.ErrorCode+" "+errorV.ErrorDetails))
+		return nil, err
+	}
+
+	r.logger.Error("Unexpected IAM token exchange response",
+		zap.Int("StatusCode", resp.StatusCode), zap.Reflect("Response", resp))
+
+	return nil,
+		util.NewError("ErrorUnclassified",
+			"Unexpected IAM token exchange response")
+}
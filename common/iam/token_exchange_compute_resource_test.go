@@ -0,0 +1,195 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package iam ...
+package iam
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	util "github.com/IBM/ibmcloud-volume-interface/lib/utils"
+	"github.com/IBM/ibmcloud-volume-interface/lib/utils/reasoncode"
+	"github.com/IBM/ibmcloud-volume-interface/provider/iam"
+)
+
+func Test_ComputeResourceExchangeIAMAPIKeyForAccessToken(t *testing.T) {
+	tokenDir, err := ioutil.TempDir("", "cr-token")
+	require.NoError(t, err)
+	defer os.RemoveAll(tokenDir)
+	tokenFile := filepath.Join(tokenDir, "token")
+
+	var testCases = []struct {
+		name               string
+		crToken            *string
+		apiHandler         func(w http.ResponseWriter, r *http.Request)
+		expectedToken      string
+		expectedError      *string
+		expectedReasonCode string
+	}{
+		{
+			name:    "success 200",
+			crToken: iam.String("cr_token_123\n"),
+			apiHandler: func(w http.ResponseWriter, r *http.Request) {
+				assert.Nil(t, r.ParseForm())
+				assert.Equal(t, computeResourceTokenGrantType, r.PostForm.Get("grant_type"))
+				assert.Equal(t, "cr_token_123", r.PostForm.Get("cr_token"))
+				assert.Equal(t, "test-profile-id", r.PostForm.Get("profile_id"))
+				w.WriteHeader(200)
+				fmt.Fprint(w, `{"access_token": "access_token_123"}`)
+			},
+			expectedToken: "access_token_123",
+		},
+		{
+			name:    "rotated token",
+			crToken: iam.String("cr_token_456"),
+			apiHandler: func(w http.ResponseWriter, r *http.Request) {
+				assert.Nil(t, r.ParseForm())
+				assert.Equal(t, "cr_token_456", r.PostForm.Get("cr_token"))
+				w.WriteHeader(200)
+				fmt.Fprint(w, `{"access_token": "access_token_456"}`)
+			},
+			expectedToken: "access_token_456",
+		},
+		{
+			name:    "unauthorised",
+			crToken: iam.String("cr_token_789"),
+			apiHandler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(400)
+				fmt.Fprint(w, `{"errorMessage": "Provided compute resource token is invalid",
+					"errorCode": "BXNIM0151E",
+					"errorDetails": "more details"
+					}`)
+			},
+			expectedError:      iam.String("IAM token exchange request failed: Provided compute resource token is invalid"),
+			expectedReasonCode: "ErrorFailedTokenExchange",
+		},
+		{
+			name:    "no error message",
+			crToken: iam.String("cr_token_789"),
+			apiHandler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(400)
+				fmt.Fprint(w, `{"errorCode": "BXNIM0151E"}`)
+			},
+			expectedError:      iam.String("Unexpected IAM token exchange response"),
+			expectedReasonCode: "ErrorUnclassified",
+		},
+		{
+			name:    "empty token file",
+			crToken: iam.String(" \n"),
+			apiHandler: func(w http.ResponseWriter, r *http.Request) {
+				t.Error("token exchange request without compute resource token")
+			},
+			expectedError:      iam.String("The compute resource token file " + tokenFile + " is empty"),
+			expectedReasonCode: "ErrorUnclassified",
+		},
+		{
+			name: "missing token file",
+			apiHandler: func(w http.ResponseWriter, r *http.Request) {
+				t.Error("token exchange request without compute resource token")
+			},
+			expectedError:      iam.String("Failed to read the compute resource token file " + tokenFile),
+			expectedReasonCode: "ErrorUnclassified",
+		},
+	}
+
+	httpSetup()
+	var apiHandler func(w http.ResponseWriter, r *http.Request)
+	mux.HandleFunc("/identity/token", func(w http.ResponseWriter, r *http.Request) {
+		apiHandler(w, r)
+	})
+
+	crAuthConfig := &ComputeResourceAuthConfiguration{
+		IamURL:           server.URL,
+		TrustedProfileID: "test-profile-id",
+		TokenFile:        tokenFile,
+	}
+	tes, err := NewTokenExchangeComputeResourceService(crAuthConfig)
+	require.NoError(t, err)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			apiHandler = testCase.apiHandler
+			if testCase.crToken != nil {
+				require.NoError(t, ioutil.WriteFile(tokenFile, []byte(*testCase.crToken), 0600))
+			} else {
+				require.NoError(t, removeFile(tokenFile))
+			}
+
+			r, err := tes.ExchangeIAMAPIKeyForAccessToken("ignored-api-key", logger)
+			if testCase.expectedError == nil {
+				assert.Nil(t, err)
+				if assert.NotNil(t, r) {
+					assert.Equal(t, testCase.expectedToken, r.Token)
+				}
+			} else {
+				assert.Nil(t, r)
+				if assert.NotNil(t, err) {
+					assert.Equal(t, *testCase.expectedError, err.Error())
+					assert.Equal(t, reasoncode.ReasonCode(testCase.expectedReasonCode), util.ErrorReasonCode(err))
+				}
+			}
+		})
+	}
+}
+
+func Test_ComputeResourceGetIAMAccountIDFromAccessToken(t *testing.T) {
+	tes, err := NewTokenExchangeComputeResourceService(&ComputeResourceAuthConfiguration{})
+	require.NoError(t, err)
+
+	accessToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"account": map[string]string{"bss": "test-account"}}).SignedString([]byte("secret"))
+	require.NoError(t, err)
+	accountID, err := tes.GetIAMAccountIDFromAccessToken(iam.AccessToken{Token: accessToken}, logger)
+	assert.Nil(t, err)
+	assert.Equal(t, "test-account", accountID)
+
+	_, err = tes.GetIAMAccountIDFromAccessToken(iam.AccessToken{Token: "not a token"}, logger)
+	assert.NotNil(t, err)
+}
+
+func Test_ComputeResourceUnsupportedExchanges(t *testing.T) {
+	tes, err := NewTokenExchangeComputeResourceService(&ComputeResourceAuthConfiguration{})
+	require.NoError(t, err)
+
+	accessToken, err := tes.ExchangeRefreshTokenForAccessToken("refresh-token", logger)
+	assert.Nil(t, accessToken)
+	assert.Equal(t, reasoncode.ReasonCode("ErrorUnsupportedTokenExchange"), util.ErrorReasonCode(err))
+
+	imsToken, err := tes.ExchangeAccessTokenForIMSToken(iam.AccessToken{Token: "access-token"}, logger)
+	assert.Nil(t, imsToken)
+	assert.Equal(t, reasoncode.ReasonCode("ErrorUnsupportedTokenExchange"), util.ErrorReasonCode(err))
+
+	imsToken, err = tes.ExchangeIAMAPIKeyForIMSToken("api-key", logger)
+	assert.Nil(t, imsToken)
+	assert.Equal(t, reasoncode.ReasonCode("ErrorUnsupportedTokenExchange"), util.ErrorReasonCode(err))
+}
+
+// removeFile removes the file if it exists
+func removeFile(path string) error {
+	err := os.Remove(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
@@ -61,6 +61,7 @@ func NewTokenExchangeIKSService(iksAuthConfig *IksAuthConfiguration) (iam.TokenE
 // tokenExchangeIKSRequest ...
 type tokenExchangeIKSRequest struct {
 	tes          *tokenExchangeIKSService
+	apiKey       string
 	request      *rest.Request
 	client       *rest.Client
 	logger       *zap.Logger
@@ -73,25 +74,28 @@ type tokenExchangeIKSResponse struct {
 	//ImsToken    string `json:"ims_token"`
 }
 
-// ExchangeRefreshTokenForAccessToken ...
+// ExchangeRefreshTokenForAccessToken is not supported, the container API does not accept refresh tokens
 func (tes *tokenExchangeIKSService) ExchangeRefreshTokenForAccessToken(refreshToken string, logger *zap.Logger) (*iam.AccessToken, error) {
-	r := tes.newTokenExchangeRequest(logger)
-	return r.exchangeForAccessToken()
+	return nil, errUnsupportedTokenExchange("refresh token", "IKS")
 }
 
-// ExchangeIAMAPIKeyForAccessToken ...
+// ExchangeIAMAPIKeyForAccessToken exchanges the given API key, or the configured one if none is given
 func (tes *tokenExchangeIKSService) ExchangeIAMAPIKeyForAccessToken(iamAPIKey string, logger *zap.Logger) (*iam.AccessToken, error) {
-	r := tes.newTokenExchangeRequest(logger)
+	if iamAPIKey == "" {
+		iamAPIKey = tes.iksAuthConfig.IamAPIKey
+	}
+	r := tes.newTokenExchangeRequest(iamAPIKey, logger)
 	return r.exchangeForAccessToken()
 }
 
 // newTokenExchangeRequest ...
-func (tes *tokenExchangeIKSService) newTokenExchangeRequest(logger *zap.Logger) *tokenExchangeIKSRequest {
+func (tes *tokenExchangeIKSService) newTokenExchangeRequest(apiKey string, logger *zap.Logger) *tokenExchangeIKSRequest {
 	client := rest.NewClient()
 	client.HTTPClient = tes.httpClient
 	retyrInterval, _ := time.ParseDuration("3s")
 	return &tokenExchangeIKSRequest{
 		tes:          tes,
+		apiKey:       apiKey,
 		request:      rest.PostRequest(fmt.Sprintf("%s/v1/iam/apikey", tes.iksAuthConfig.PrivateAPIRoute)),
 		client:       client,
 		logger:       logger,
@@ -101,16 +105,22 @@ func (tes *tokenExchangeIKSService) newTokenExchangeRequest(logger *zap.Logger)
 
 // ExchangeAccessTokenForIMSToken ...
 func (tes *tokenExchangeIKSService) ExchangeAccessTokenForIMSToken(accessToken iam.AccessToken, logger *zap.Logger) (*iam.IMSToken, error) {
-	return nil, nil
+	return nil, errUnsupportedTokenExchange("IMS token", "the container API")
 }
 
 // ExchangeIAMAPIKeyForIMSToken ...
 func (tes *tokenExchangeIKSService) ExchangeIAMAPIKeyForIMSToken(iamAPIKey string, logger *zap.Logger) (*iam.IMSToken, error) {
-	return nil, nil
+	return nil, errUnsupportedTokenExchange("IMS token", "the container API")
 }
 
+// GetIAMAccountIDFromAccessToken returns the account ID of the access token claims
 func (tes *tokenExchangeIKSService) GetIAMAccountIDFromAccessToken(accessToken iam.AccessToken, logger *zap.Logger) (accountID string, err error) {
-	return "Not required to implement", nil
+	claims, err := ParseAccessToken(accessToken.Token)
+	if err != nil {
+		logger.Error("Failed to parse the access token", zap.Error(err))
+		return "", err
+	}
+	return claims.AccountID(), nil
 }
 
 // exchangeForAccessToken ...
@@ -136,7 +146,7 @@ func (r *tokenExchangeIKSRequest) sendTokenExchangeRequest() (*tokenExchangeIKSR
 	var apikey = struct {
 		APIKey string `json:"apikey"`
 	}{
-		APIKey: r.tes.iksAuthConfig.IamAPIKey,
+		APIKey: r.apiKey,
 	}
 	r.request = r.request.Body(&apikey)
 
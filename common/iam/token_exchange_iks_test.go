@@ -18,12 +18,14 @@
 package iam
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"testing"
 
+	"github.com/dgrijalva/jwt-go"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -52,7 +54,7 @@ func TestMain(m *testing.M) {
 	os.Exit(m.Run())
 }
 
-func Test_IKSExchangeRefreshTokenForAccessToken_Success(t *testing.T) {
+func Test_IKSExchangeIAMAPIKeyForAccessToken_Success(t *testing.T) {
 	logger := zap.New(
 		zapcore.NewCore(zapcore.NewJSONEncoder(zap.NewDevelopmentEncoderConfig()), consoleDebugging, lowPriority),
 		zap.AddCaller(),
@@ -74,7 +76,7 @@ func Test_IKSExchangeRefreshTokenForAccessToken_Success(t *testing.T) {
 	tes, err := NewTokenExchangeIKSService(iksAuthConfig)
 	assert.NoError(t, err)
 
-	r, err := tes.ExchangeRefreshTokenForAccessToken("testrefreshtoken", logger)
+	r, err := tes.ExchangeIAMAPIKeyForAccessToken("testapikey", logger)
 	assert.Nil(t, err)
 	if assert.NotNil(t, r) {
 		assert.Equal(t, (*r).Token, "at_success")
@@ -87,7 +89,7 @@ func httpSetup() {
 	server = httptest.NewServer(mux)
 }
 
-func Test_IKSExchangeRefreshTokenForAccessToken_FailedDuringRequest(t *testing.T) {
+func Test_IKSExchangeIAMAPIKeyForAccessToken_FailedDuringRequest(t *testing.T) {
 	logger := zap.New(
 		zapcore.NewCore(zapcore.NewJSONEncoder(zap.NewDevelopmentEncoderConfig()), consoleDebugging, lowPriority),
 		zap.AddCaller(),
@@ -112,7 +114,7 @@ func Test_IKSExchangeRefreshTokenForAccessToken_FailedDuringRequest(t *testing.T
 	tes, err := NewTokenExchangeIKSService(iksAuthConfig)
 	assert.NoError(t, err)
 
-	r, err := tes.ExchangeRefreshTokenForAccessToken("badrefreshtoken", logger)
+	r, err := tes.ExchangeIAMAPIKeyForAccessToken("badapikey", logger)
 	assert.Nil(t, r)
 	if assert.NotNil(t, err) {
 		assert.Equal(t, "IAM token exchange request failed: did not work", err.Error())
@@ -120,7 +122,7 @@ func Test_IKSExchangeRefreshTokenForAccessToken_FailedDuringRequest(t *testing.T
 	}
 }
 
-func Test_IKSExchangeRefreshTokenForAccessToken_FailedDuringRequest_no_message(t *testing.T) {
+func Test_IKSExchangeIAMAPIKeyForAccessToken_FailedDuringRequest_no_message(t *testing.T) {
 	logger := zap.New(
 		zapcore.NewCore(zapcore.NewJSONEncoder(zap.NewDevelopmentEncoderConfig()), consoleDebugging, lowPriority),
 		zap.AddCaller(),
@@ -141,7 +143,7 @@ func Test_IKSExchangeRefreshTokenForAccessToken_FailedDuringRequest_no_message(t
 	tes, err := NewTokenExchangeIKSService(iksAuthConfig)
 	assert.NoError(t, err)
 
-	r, err := tes.ExchangeRefreshTokenForAccessToken("badrefreshtoken", logger)
+	r, err := tes.ExchangeIAMAPIKeyForAccessToken("badapikey", logger)
 	assert.Nil(t, r)
 	if assert.NotNil(t, err) {
 		assert.Equal(t, "Unexpected IAM token exchange response", err.Error())
@@ -149,7 +151,7 @@ func Test_IKSExchangeRefreshTokenForAccessToken_FailedDuringRequest_no_message(t
 	}
 }
 
-func Test_IKSExchangeRefreshTokenForAccessToken_FailedWrongApiUrl(t *testing.T) {
+func Test_IKSExchangeIAMAPIKeyForAccessToken_FailedWrongApiUrl(t *testing.T) {
 	logger := zap.New(
 		zapcore.NewCore(zapcore.NewJSONEncoder(zap.NewDevelopmentEncoderConfig()), consoleDebugging, lowPriority),
 		zap.AddCaller(),
@@ -171,7 +173,7 @@ func Test_IKSExchangeRefreshTokenForAccessToken_FailedWrongApiUrl(t *testing.T)
 	tes, err := NewTokenExchangeIKSService(iksAuthConfig)
 	assert.NoError(t, err)
 
-	r, err := tes.ExchangeRefreshTokenForAccessToken("testrefreshtoken", logger)
+	r, err := tes.ExchangeIAMAPIKeyForAccessToken("testapikey", logger)
 	assert.Nil(t, r)
 
 	if assert.NotNil(t, err) {
@@ -182,7 +184,7 @@ func Test_IKSExchangeRefreshTokenForAccessToken_FailedWrongApiUrl(t *testing.T)
 	}
 }
 
-func Test_IKSExchangeRefreshTokenForAccessToken_FailedRequesting_unclassified_error(t *testing.T) {
+func Test_IKSExchangeIAMAPIKeyForAccessToken_FailedRequesting_unclassified_error(t *testing.T) {
 	logger := zap.New(
 		zapcore.NewCore(zapcore.NewJSONEncoder(zap.NewDevelopmentEncoderConfig()), consoleDebugging, lowPriority),
 		zap.AddCaller(),
@@ -203,7 +205,7 @@ func Test_IKSExchangeRefreshTokenForAccessToken_FailedRequesting_unclassified_er
 	tes, err := iam.NewTokenExchangeService(iksAuthConfig)
 	assert.NoError(t, err)
 
-	r, err := tes.ExchangeRefreshTokenForAccessToken("badrefreshtoken", logger)
+	r, err := tes.ExchangeIAMAPIKeyForAccessToken("badapikey", logger)
 	assert.Nil(t, r)
 
 	if assert.NotNil(t, err) {
@@ -296,3 +298,93 @@ func Test_IKSExchangeIAMAPIKeyForAccessToken(t *testing.T) {
 		})
 	}
 }
+
+func Test_IKSExchangeIAMAPIKeyForAccessToken_APIKey(t *testing.T) {
+	var testCases = []struct {
+		name           string
+		apiKey         string
+		expectedAPIKey string
+	}{
+		{
+			name:           "given API key",
+			apiKey:         "apikey1",
+			expectedAPIKey: "apikey1",
+		},
+		{
+			name:           "configured API key",
+			expectedAPIKey: "configured-apikey",
+		},
+	}
+
+	httpSetup()
+	var requestAPIKey string
+	mux.HandleFunc("/v1/iam/apikey",
+		func(w http.ResponseWriter, r *http.Request) {
+			var body struct {
+				APIKey string `json:"apikey"`
+			}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			requestAPIKey = body.APIKey
+			w.WriteHeader(200)
+			fmt.Fprint(w, `{"token": "access_token_`+body.APIKey+`"}`)
+		},
+	)
+
+	iksAuthConfig := &IksAuthConfiguration{
+		PrivateAPIRoute: server.URL,
+		IamAPIKey:       "configured-apikey",
+	}
+	tes, err := NewTokenExchangeIKSService(iksAuthConfig)
+	assert.NoError(t, err)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r, err := tes.ExchangeIAMAPIKeyForAccessToken(testCase.apiKey, logger)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expectedAPIKey, requestAPIKey)
+			if assert.NotNil(t, r) {
+				assert.Equal(t, "access_token_"+testCase.expectedAPIKey, r.Token)
+			}
+		})
+	}
+}
+
+func Test_IKSGetIAMAccountIDFromAccessToken(t *testing.T) {
+	tes, err := NewTokenExchangeIKSService(&IksAuthConfiguration{})
+	assert.NoError(t, err)
+
+	accessToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"account": map[string]string{"bss": "test-account"}}).SignedString([]byte("secret"))
+	assert.NoError(t, err)
+	accountID, err := tes.GetIAMAccountIDFromAccessToken(iam.AccessToken{Token: accessToken}, logger)
+	assert.NoError(t, err)
+	assert.Equal(t, "test-account", accountID)
+
+	accountID, err = tes.GetIAMAccountIDFromAccessToken(iam.AccessToken{Token: "not a token"}, logger)
+	assert.Error(t, err)
+	assert.Equal(t, "", accountID)
+}
+
+func Test_IKSUnsupportedExchanges(t *testing.T) {
+	tes, err := NewTokenExchangeIKSService(&IksAuthConfiguration{})
+	assert.NoError(t, err)
+
+	imsToken, err := tes.ExchangeAccessTokenForIMSToken(iam.AccessToken{Token: "access-token"}, logger)
+	assert.Nil(t, imsToken)
+	if assert.Error(t, err) {
+		assert.Equal(t, "Exchange for IMS token is not supported with the container API", err.Error())
+		assert.Equal(t, reasoncode.ReasonCode("ErrorUnsupportedTokenExchange"), util.ErrorReasonCode(err))
+	}
+
+	imsToken, err = tes.ExchangeIAMAPIKeyForIMSToken("api-key", logger)
+	assert.Nil(t, imsToken)
+	if assert.Error(t, err) {
+		assert.Equal(t, reasoncode.ReasonCode("ErrorUnsupportedTokenExchange"), util.ErrorReasonCode(err))
+	}
+
+	accessToken, err := tes.ExchangeRefreshTokenForAccessToken("refresh-token", logger)
+	assert.Nil(t, accessToken)
+	if assert.Error(t, err) {
+		assert.Equal(t, "Exchange for refresh token is not supported with IKS", err.Error())
+		assert.Equal(t, reasoncode.ReasonCode("ErrorUnsupportedTokenExchange"), util.ErrorReasonCode(err))
+	}
+}
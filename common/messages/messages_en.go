@@ -44,6 +44,34 @@ var messagesEn = map[string]util.Message{
 		RC:          500,
 		Action:      "Review the error that is returned. If the volume creation service is currently unavailable, try to manually create the volume with the 'ibmcloud is volume-create' command.",
 	},
+	"FailedToRestoreSnapshot": {
+		Code:        "FailedToRestoreSnapshot",
+		Description: "Failed to create volume from snapshot ID '%s' with the storage provider",
+		Type:        util.ProvisioningFailed,
+		RC:          500,
+		Action:      "Verify that the snapshot ID exists and is in stable state. Run 'ibmcloud is snapshots' to list available snapshots in your account.",
+	},
+	"FailedToExpandVolume": {
+		Code:        "FailedToExpandVolume",
+		Description: "Failed to expand volume '%s' with the storage provider",
+		Type:        util.UpdateFailed,
+		RC:          500,
+		Action:      "Verify that the volume is in 'available' state and that the requested capacity is supported by its profile. Run 'ibmcloud is volume <volume_ID>' to see the details of the volume.",
+	},
+	"FailedToUpdateVolumeProfile": {
+		Code:        "FailedToUpdateVolumeProfile",
+		Description: "Failed to change the profile of volume '%s' to '%s' with the storage provider",
+		Type:        util.UpdateFailed,
+		RC:          500,
+		Action:      "Verify that the volume is in 'available' state and that the requested profile and IOPS are supported for the volume capacity. Run 'ibmcloud is volume-profiles' to list available profiles.",
+	},
+	"VolumeNameConflict": {
+		Code:        "VolumeNameConflict",
+		Description: "A volume with the name '%s' already exists as volume ID '%s', but with a different zone, capacity, profile or resource group.",
+		Type:        util.ProvisioningFailed,
+		RC:          409,
+		Action:      "Use a different volume name, or delete the existing volume. Run 'ibmcloud is volumes' to list available volumes in your account.",
+	},
 	"FailedToDeleteVolume": {
 		Code:        "FailedToDeleteVolume",
 		Description: "The volume ID '%d' could not be deleted from your VPC.",
@@ -86,6 +114,27 @@ var messagesEn = map[string]util.Message{
 		RC:          400,
 		Action:      "Please check the snapshot ID once, You many need to verify by using 'ibmcloud is' cli.",
 	},
+	"SnapshotIDNotFound": {
+		Code:        "SnapshotIDNotFound",
+		Description: "A snapshot with the specified snapshot ID '%s' could not be found.",
+		Type:        util.RetrivalFailed,
+		RC:          404,
+		Action:      "Verify that the snapshot ID exists. Run 'ibmcloud is snapshots' to list available snapshots in your account.",
+	},
+	"RetrieveSnapshotFailed": {
+		Code:        "RetrieveSnapshotFailed",
+		Description: "Failed to retrieve the snapshot with the specified snapshot ID '%s'.",
+		Type:        util.RetrivalFailed,
+		RC:          500,
+		Action:      "Verify that the snapshot ID exists. Run 'ibmcloud is snapshots' to list available snapshots in your account, and retry the request.",
+	},
+	"SnapshotVolumeMismatch": {
+		Code:        "SnapshotVolumeMismatch",
+		Description: "The snapshot '%s' was not taken from the volume '%s'.",
+		Type:        util.InvalidRequest,
+		RC:          400,
+		Action:      "Verify the source volume of the snapshot. Run 'ibmcloud is snapshot <snapshot-id>' to get the snapshot details.",
+	},
 	"VolumeAttachFindFailed": {
 		Code:        VolumeAttachFindFailed,
 		Description: "No volume attachment could be found for the specified volume ID '%s' and instance ID '%s'.",
@@ -121,6 +170,13 @@ var messagesEn = map[string]util.Message{
 		RC:          500,
 		Action:      "Verify that the specified instance ID has active volume attachments. Run 'ibmcloud is in-vols INSTANCE_ID' to list active volume attachments for your instance ID.",
 	},
+	"VolumeInUse": {
+		Code:        "VolumeInUse",
+		Description: "The volume ID '%s' can not be deleted as it is attached to the instances '%s'.",
+		Type:        util.DeletionFailed,
+		RC:          409,
+		Action:      "Detach the volume from the instances before deleting it, or delete it with the force detach option. Run 'ibmcloud is in-vols INSTANCE_ID' to list active volume attachments for your instance ID.",
+	},
 	"InvalidVolumeID": {
 		Code:        "InvalidVolumeID",
 		Description: "The specified volume ID '%s' is not valid.",
@@ -142,6 +198,20 @@ var messagesEn = map[string]util.Message{
 		RC:          400,
 		Action:      "Verify the specified volume capacity. The volume capacity must be a positive number between 10 GB and 2000 GB. ",
 	},
+	"VolumeCapacityShrinkInvalid": {
+		Code:        "VolumeCapacityShrinkInvalid",
+		Description: "The requested volume capacity '%d' GB must be greater than the current capacity '%d' GB.",
+		Type:        util.InvalidRequest,
+		RC:          400,
+		Action:      "Volumes can only be expanded. Specify a capacity larger than the current capacity of the volume.",
+	},
+	"VolumeCapacityBelowSnapshotSize": {
+		Code:        "VolumeCapacityBelowSnapshotSize",
+		Description: "The requested volume capacity '%d' GB is smaller than the size '%d' GB of snapshot '%s'.",
+		Type:        util.InvalidRequest,
+		RC:          400,
+		Action:      "Specify a capacity equal to or larger than the size of the snapshot. Run 'ibmcloud is snapshot <snapshot-id>' to get the snapshot size.",
+	},
 	"IopsInvalid": {
 		Code:        "IopsInvalid",
 		Description: "The specified volume IOPS '%s' is not valid for the selected volume profile. ",
@@ -156,6 +226,83 @@ var messagesEn = map[string]util.Message{
 		RC:          400,
 		Action:      "Review available volume profiles and IOPS in the IBM Cloud Block Storage for VPC documentation https://cloud.ibm.com/docs/vpc-on-classic-block-storage?topic=vpc-on-classic-block-storage-block-storage-profiles.",
 	},
+	"VolumeProfileCapacityInvalid": {
+		Code:        "VolumeProfileCapacityInvalid",
+		Description: "The specified volume capacity '%d' GB is not valid for the volume profile '%s'. The volume profile supports capacities from %d GB to %d GB.",
+		Type:        util.InvalidRequest,
+		RC:          400,
+		Action:      "Review available volume profiles and capacities in the IBM Cloud Block Storage for VPC documentation https://cloud.ibm.com/docs/vpc-on-classic-block-storage?topic=vpc-on-classic-block-storage-block-storage-profiles.",
+	},
+	"VolumeProfileNotFound": {
+		Code:        "VolumeProfileNotFound",
+		Description: "The volume profile '%s' could not be found.",
+		Type:        util.InvalidRequest,
+		RC:          400,
+		Action:      "Run 'ibmcloud is volume-profiles' to list available volume profiles.",
+	},
+	"ListZonesFailed": {
+		Code:        "ListZonesFailed",
+		Description: "Failed to list the zones of region '%s'.",
+		Type:        util.RetrivalFailed,
+		RC:          500,
+		Action:      "Run 'ibmcloud is zones' to verify that the zones of the region can be listed. ",
+	},
+	"RegionNotFound": {
+		Code:        "RegionNotFound",
+		Description: "The region could not be determined from the endpoint '%s'.",
+		Type:        util.InvalidRequest,
+		RC:          400,
+		Action:      "Verify that the VPC endpoint URL in the provider configuration is a regional endpoint, for example https://us-south.iaas.cloud.ibm.com. ",
+	},
+	"InvalidZone": {
+		Code:        "InvalidZone",
+		Description: "The zone '%s' is not an available zone of region '%s'. Available zones are: %s.",
+		Type:        util.InvalidRequest,
+		RC:          400,
+		Action:      "Specify one of the available zones. Run 'ibmcloud is zones' to list the zones of the region. ",
+	},
+	"InstanceNotFound": {
+		Code:        "InstanceNotFound",
+		Description: "The instance with ID '%s' could not be found.",
+		Type:        util.InvalidRequest,
+		RC:          404,
+		Action:      "Verify that the instance ID is correct. Run 'ibmcloud is instances' to list available instances. ",
+	},
+	"InstanceNotRunning": {
+		Code:        "InstanceNotRunning",
+		Description: "The volume can not be attached as the instance with ID '%s' is in '%s' state.",
+		Type:        util.InvalidRequest,
+		RC:          400,
+		Action:      "Start the instance or wait until it is running, then retry the attach. Run 'ibmcloud is instance <instance-id>' to get the instance status. ",
+	},
+	"InstanceZoneMismatch": {
+		Code:        "InstanceZoneMismatch",
+		Description: "The instance with ID '%s' is in zone '%s', but the volume with ID '%s' is in zone '%s'.",
+		Type:        util.InvalidRequest,
+		RC:          400,
+		Action:      "A volume can only be attached to an instance in the same zone. Specify an instance in the zone of the volume. ",
+	},
+	"ResourceGroupNotFound": {
+		Code:        "ResourceGroupNotFound",
+		Description: "The resource group '%s' could not be found.",
+		Type:        util.InvalidRequest,
+		RC:          400,
+		Action:      "Run 'ibmcloud resource groups' to list the resource groups that you have access to. ",
+	},
+	"ResourceGroupLookupFailed": {
+		Code:        "ResourceGroupLookupFailed",
+		Description: "Failed to get the ID of resource group '%s'.",
+		Type:        util.RetrivalFailed,
+		RC:          500,
+		Action:      "Verify that the resource group can be listed by running 'ibmcloud resource groups', or provide the resource group ID instead of the name. ",
+	},
+	"InstanceAttachmentLimitReached": {
+		Code:        "InstanceAttachmentLimitReached",
+		Description: "The volume can not be attached as the instance with ID '%s' already has %d data volumes attached, which is the limit of %d.",
+		Type:        util.InvalidRequest,
+		RC:          400,
+		Action:      "Attach the volume to another instance in the zone of the volume, or detach a volume from the instance. ",
+	},
 	"EmptyResourceGroup": {
 		Code:        "EmptyResourceGroup",
 		Description: "Resource group information could not be found.",
@@ -184,6 +331,20 @@ var messagesEn = map[string]util.Message{
 		RC:          500,
 		Action:      "Please check your input",
 	},
+	"SnapshotNotInStableState": {
+		Code:        "SnapshotNotInStableState",
+		Description: "Snapshot %s did not get stable status within timeout period.",
+		Type:        util.ProvisioningFailed,
+		RC:          500,
+		Action:      "Verify the status of the snapshot. Run 'ibmcloud is snapshot <snapshot-id>' to get the snapshot details.",
+	},
+	"VolumeNotInValidStateCleanup": {
+		Code:        "VolumeNotInValidStateCleanup",
+		Description: "Volume %s did not get valid (available) status within timeout period. Cleanup action taken: %s.",
+		Type:        util.ProvisioningFailed,
+		RC:          500,
+		Action:      "Run 'ibmcloud is volume <volume_ID>' to check the volume. If the volume was not deleted, delete it to avoid charges for an unusable volume.",
+	},
 	"VolumeDeletionInProgress": {
 		Code:        "VolumeDeletionInProgress",
 		Description: "Volume %s deletion in progress.",
@@ -198,6 +359,27 @@ var messagesEn = map[string]util.Message{
 		RC:          404,
 		Action:      "Run 'ibmcloud is volumes' to list available volumes in your account.",
 	},
+	"ListSnapshotsFailed": {
+		Code:        "ListSnapshotsFailed",
+		Description: "Unable to fetch list of snapshots.",
+		Type:        util.RetrivalFailed,
+		RC:          404,
+		Action:      "Run 'ibmcloud is snapshots' to list available snapshots in your account.",
+	},
+	"InvalidListFilter": {
+		Code:        "InvalidListFilter",
+		Description: "The filter '%s' is not supported by the list call. Supported filters are: %s",
+		Type:        util.InvalidRequest,
+		RC:          400,
+		Action:      "Remove the unsupported filter from the request and retry.",
+	},
+	"InvalidListFilterValue": {
+		Code:        "InvalidListFilterValue",
+		Description: "The value '%s' specified for the filter '%s' of the list call is not valid.",
+		Type:        util.InvalidRequest,
+		RC:          400,
+		Action:      "Verify the filter value. Capacity filters must be a positive number of GiB.",
+	},
 	"InvalidListVolumesLimit": {
 		Code:        "InvalidListVolumesLimit",
 		Description: "The value '%v' specified in the limit parameter of the list volume call is not valid.",
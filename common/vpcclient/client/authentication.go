@@ -19,24 +19,58 @@ package client
 
 import (
 	"errors"
+	"strings"
 )
 
 // ErrAuthenticationRequired is returned if a request is made before an authentication
 // token has been provided to the client
 var ErrAuthenticationRequired = errors.New("authentication token required")
 
+// TokenSource provides the authentication token of the requests, it is able to renew the token
+//go:generate counterfeiter -o fakes/token_source.go --fake-name TokenSource . TokenSource
+type TokenSource interface {
+	// Token returns the token to use for the requests
+	Token() (string, error)
+
+	// Refresh renews the token as the given one was rejected by the API. The token is not renewed
+	// again if it was already renewed since then, e.g. for concurrent requests
+	Refresh(rejectedToken string) (string, error)
+}
+
 type authenticationHandler struct {
 	authToken     string
 	resourceGroup string
+	tokenSource   TokenSource
 }
 
 // Before is called before each request
 func (a *authenticationHandler) Before(request *Request) error {
 	request.resourceGroup = a.resourceGroup
 
-	if a.authToken == "" {
+	authToken := a.authToken
+	if a.tokenSource != nil {
+		var err error
+		authToken, err = a.tokenSource.Token()
+		if err != nil {
+			return err
+		}
+	}
+
+	if authToken == "" {
 		return ErrAuthenticationRequired
 	}
-	request.headers.Set("Authorization", "Bearer "+a.authToken)
+	request.headers.Set("Authorization", "Bearer "+authToken)
 	return nil
 }
+
+// Refresh renews the token used by the request, it returns false if the token can not be renewed
+func (a *authenticationHandler) Refresh(request *Request) (bool, error) {
+	if a.tokenSource == nil {
+		return false, nil
+	}
+	_, err := a.tokenSource.Refresh(strings.TrimPrefix(request.headers.Get("Authorization"), "Bearer "))
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
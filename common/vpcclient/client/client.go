@@ -29,6 +29,7 @@ import (
 // handler ...
 type handler interface {
 	Before(request *Request) error
+	Refresh(request *Request) (bool, error)
 }
 
 // SessionClient provides an interface for a REST API client
@@ -37,8 +38,10 @@ type SessionClient interface {
 	NewRequest(operation *Operation) *Request
 	WithDebug(writer io.Writer) SessionClient
 	WithAuthToken(authToken string) SessionClient
+	WithTokenSource(tokenSource TokenSource) SessionClient
 	WithPathParameter(name, value string) SessionClient
 	WithQueryValue(name, value string) SessionClient
+	WithContext(ctx context.Context) SessionClient
 }
 
 type client struct {
@@ -115,6 +118,15 @@ func (c *client) WithAuthToken(authToken string) SessionClient {
 	return c
 }
 
+// WithTokenSource supplies the source of the authentication token to use for all requests made by this session,
+// the requests rejected for the token are sent once again with a renewed token
+func (c *client) WithTokenSource(tokenSource TokenSource) SessionClient {
+	c.authenHandler = &authenticationHandler{
+		tokenSource: tokenSource,
+	}
+	return c
+}
+
 // WithPathParameter adds a path parameter to the request
 func (c *client) WithPathParameter(name, value string) SessionClient {
 	c.pathParams[name] = value
@@ -126,3 +138,11 @@ func (c *client) WithQueryValue(name, value string) SessionClient {
 	c.queryValues.Set(name, value)
 	return c
 }
+
+// WithContext returns a copy of this SessionClient whose requests are sent with the supplied context,
+// this SessionClient is left unchanged so that it can be shared by concurrent requests
+func (c *client) WithContext(ctx context.Context) SessionClient {
+	contextClient := *c
+	contextClient.context = ctx
+	return &contextClient
+}
@@ -20,6 +20,7 @@ package client_test
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -28,10 +29,12 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/client"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/client/fakes"
 	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
 	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/riaas/test"
 )
@@ -177,6 +180,230 @@ func TestClient(t *testing.T) {
 	}
 }
 
+func TestErrorStatus(t *testing.T) {
+	testcases := []struct {
+		name string
+
+		responseCode int
+		retryAfter   string
+		responseBody string
+		receiver     client.HTTPStatusError
+
+		expectedStatusCode int
+		verifyRetryAfter   func(t *testing.T, retryAfter time.Duration)
+	}{
+		{
+			name:               "keeps the status code of the error",
+			responseCode:       http.StatusBadRequest,
+			responseBody:       "{\"errors\":[{\"code\":\"validation_invalid_name\",\"message\":\"testerr\"}]}",
+			receiver:           &models.Error{},
+			expectedStatusCode: http.StatusBadRequest,
+			verifyRetryAfter: func(t *testing.T, retryAfter time.Duration) {
+				assert.Equal(t, time.Duration(0), retryAfter)
+			},
+		}, {
+			name:               "parses Retry-After in seconds",
+			responseCode:       http.StatusTooManyRequests,
+			retryAfter:         "3",
+			responseBody:       "{\"errors\":[{\"message\":\"testerr\"}]}",
+			receiver:           &models.Error{},
+			expectedStatusCode: http.StatusTooManyRequests,
+			verifyRetryAfter: func(t *testing.T, retryAfter time.Duration) {
+				assert.Equal(t, 3*time.Second, retryAfter)
+			},
+		}, {
+			name:               "parses Retry-After as HTTP date",
+			responseCode:       http.StatusServiceUnavailable,
+			retryAfter:         time.Now().Add(time.Hour).UTC().Format(http.TimeFormat),
+			responseBody:       "{\"errors\":[{\"message\":\"testerr\"}]}",
+			receiver:           &models.Error{},
+			expectedStatusCode: http.StatusServiceUnavailable,
+			verifyRetryAfter: func(t *testing.T, retryAfter time.Duration) {
+				assert.True(t, retryAfter > 58*time.Minute)
+				assert.True(t, retryAfter <= time.Hour)
+			},
+		}, {
+			name:               "ignores invalid Retry-After",
+			responseCode:       http.StatusTooManyRequests,
+			retryAfter:         "soon",
+			responseBody:       "{\"errors\":[{\"message\":\"testerr\"}]}",
+			receiver:           &models.Error{},
+			expectedStatusCode: http.StatusTooManyRequests,
+			verifyRetryAfter: func(t *testing.T, retryAfter time.Duration) {
+				assert.Equal(t, time.Duration(0), retryAfter)
+			},
+		}, {
+			name:               "keeps the status code when the body is not an error",
+			responseCode:       http.StatusServiceUnavailable,
+			retryAfter:         "7",
+			responseBody:       "<html><body>Service unavailable</body></html>",
+			receiver:           &models.Error{},
+			expectedStatusCode: http.StatusServiceUnavailable,
+			verifyRetryAfter: func(t *testing.T, retryAfter time.Duration) {
+				assert.Equal(t, 7*time.Second, retryAfter)
+			},
+		}, {
+			name:               "keeps the status code of the IKS error",
+			responseCode:       http.StatusTooManyRequests,
+			retryAfter:         "5",
+			responseBody:       "{\"code\":\"ST0001\",\"description\":\"testerr\"}",
+			receiver:           &models.IksError{},
+			expectedStatusCode: http.StatusTooManyRequests,
+			verifyRetryAfter: func(t *testing.T, retryAfter time.Duration) {
+				assert.Equal(t, 5*time.Second, retryAfter)
+			},
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			s := httptest.NewServer(mux)
+			defer s.Close()
+
+			mux.HandleFunc("/resource", func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if testcase.retryAfter != "" {
+					w.Header().Set("Retry-After", testcase.retryAfter)
+				}
+				w.WriteHeader(testcase.responseCode)
+				fmt.Fprint(w, testcase.responseBody)
+			})
+
+			riaas := client.New(context.Background(), s.URL, url.Values{}, http.DefaultClient, "test-context", "default").WithAuthToken("auth-token")
+			resp, err := riaas.NewRequest(getOperation).JSONError(testcase.receiver).Invoke()
+			if assert.Error(t, err) {
+				assert.Equal(t, testcase.receiver, err)
+			}
+			defer resp.Body.Close()
+
+			switch receiver := testcase.receiver.(type) {
+			case *models.Error:
+				assert.Equal(t, testcase.expectedStatusCode, receiver.StatusCode)
+				testcase.verifyRetryAfter(t, receiver.RetryAfter)
+			case *models.IksError:
+				assert.Equal(t, testcase.expectedStatusCode, receiver.StatusCode)
+				testcase.verifyRetryAfter(t, receiver.RetryAfter)
+			}
+		})
+	}
+}
+
+func TestTokenRefresh(t *testing.T) {
+	testcases := []struct {
+		name string
+
+		responseCode int
+		responseBody string
+		refreshErr   error
+		withoutToken bool
+
+		expectedCalls    int
+		expectedRefresh  int
+		expectedStatus   int
+		expectedErrorMsg string
+	}{
+		{
+			name:            "request is sent again with the renewed token on 401",
+			responseCode:    http.StatusUnauthorized,
+			responseBody:    "{\"errors\":[{\"message\":\"unauthorized\"}]}",
+			expectedCalls:   2,
+			expectedRefresh: 1,
+			expectedStatus:  http.StatusOK,
+		}, {
+			name:            "request is sent again with the renewed token on token_invalid",
+			responseCode:    http.StatusBadRequest,
+			responseBody:    "{\"errors\":[{\"code\":\"token_invalid\",\"message\":\"expired\"}]}",
+			expectedCalls:   2,
+			expectedRefresh: 1,
+			expectedStatus:  http.StatusOK,
+		}, {
+			name:             "original error is returned when the token can not be renewed",
+			responseCode:     http.StatusUnauthorized,
+			responseBody:     "{\"errors\":[{\"message\":\"unauthorized\"}]}",
+			refreshErr:       errors.New("token exchange failed"),
+			expectedCalls:    1,
+			expectedRefresh:  1,
+			expectedStatus:   http.StatusUnauthorized,
+			expectedErrorMsg: "Trace Code:, unauthorized Please check ",
+		}, {
+			name:             "request is not sent again without token source",
+			responseCode:     http.StatusUnauthorized,
+			responseBody:     "{\"errors\":[{\"message\":\"unauthorized\"}]}",
+			withoutToken:     true,
+			expectedCalls:    1,
+			expectedStatus:   http.StatusUnauthorized,
+			expectedErrorMsg: "Trace Code:, unauthorized Please check ",
+		}, {
+			name:             "other errors are not retried",
+			responseCode:     http.StatusBadRequest,
+			responseBody:     "{\"errors\":[{\"code\":\"validation_invalid_name\",\"message\":\"invalid\"}]}",
+			expectedCalls:    1,
+			expectedStatus:   http.StatusBadRequest,
+			expectedErrorMsg: "Trace Code:, invalid Please check ",
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			s := httptest.NewServer(mux)
+			defer s.Close()
+
+			calls := 0
+			mux.HandleFunc("/resource", func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				w.Header().Set("Content-Type", "application/json")
+				if r.Header.Get("Authorization") == "Bearer new-token" {
+					w.WriteHeader(http.StatusOK)
+					fmt.Fprint(w, "{}")
+					return
+				}
+				w.WriteHeader(testcase.responseCode)
+				fmt.Fprint(w, testcase.responseBody)
+			})
+
+			tokenSource := &fakes.TokenSource{}
+			tokenSource.TokenReturnsOnCall(0, "old-token", nil)
+			tokenSource.TokenReturnsOnCall(1, "new-token", nil)
+			tokenSource.RefreshReturns("new-token", testcase.refreshErr)
+
+			riaas := client.New(context.Background(), s.URL, url.Values{}, http.DefaultClient, "test-context", "default")
+			if testcase.withoutToken {
+				riaas = riaas.WithAuthToken("old-token")
+			} else {
+				riaas = riaas.WithTokenSource(tokenSource)
+			}
+
+			var errResult models.Error
+			resp, err := riaas.NewRequest(postOperation).JSONBody(map[string]string{"name": "value"}).JSONError(&errResult).Invoke()
+			if testcase.expectedErrorMsg != "" {
+				if assert.Error(t, err) {
+					assert.Equal(t, testcase.expectedErrorMsg, err.Error())
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, testcase.expectedStatus, resp.StatusCode)
+			assert.Equal(t, testcase.expectedCalls, calls)
+			if assert.Equal(t, testcase.expectedRefresh, tokenSource.RefreshCallCount()) && testcase.expectedRefresh > 0 {
+				assert.Equal(t, "old-token", tokenSource.RefreshArgsForCall(0))
+			}
+			defer resp.Body.Close()
+		})
+	}
+
+	// The token source error is returned before the request is sent
+	tokenSource := &fakes.TokenSource{}
+	tokenSource.TokenReturns("", errors.New("token exchange failed"))
+	riaas := client.New(context.Background(), "http://127.0.0.1:1", url.Values{}, http.DefaultClient, "test-context", "default").WithTokenSource(tokenSource)
+	resp, err := riaas.NewRequest(getOperation).Invoke()
+	assert.Nil(t, resp)
+	if assert.Error(t, err) {
+		assert.Equal(t, "token exchange failed", err.Error())
+	}
+}
+
 func TestDebugMode(t *testing.T) {
 	var (
 		riaas   client.SessionClient
@@ -360,3 +587,31 @@ func TestWithQueryValue(t *testing.T) {
 	assert.NotNil(t, riaas)
 	defer s.Close()
 }
+
+func TestWithContext(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/resource", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"name":"resource"}`)
+	})
+	s := httptest.NewServer(mux)
+	defer s.Close()
+
+	riaas := client.New(context.Background(), s.URL, url.Values{}, http.DefaultClient, "test-context", "default").WithAuthToken("auth-token")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	contextRiaas := riaas.WithContext(ctx)
+
+	// The requests of the copy are sent with its context
+	var result map[string]interface{}
+	_, err := contextRiaas.NewRequest(getOperation).JSONSuccess(&result).Invoke()
+	if assert.Error(t, err) {
+		assert.True(t, errors.Is(err, context.Canceled))
+	}
+
+	// The client itself is left unchanged
+	_, err = riaas.NewRequest(getOperation).JSONSuccess(&result).Invoke()
+	assert.NoError(t, err)
+	assert.Equal(t, "resource", result["name"])
+}
@@ -2,6 +2,7 @@
 package fakes
 
 import (
+	"context"
 	"io"
 	"sync"
 
@@ -31,6 +32,17 @@ type SessionClient struct {
 	withAuthTokenReturnsOnCall map[int]struct {
 		result1 client.SessionClient
 	}
+	WithContextStub        func(context.Context) client.SessionClient
+	withContextMutex       sync.RWMutex
+	withContextArgsForCall []struct {
+		arg1 context.Context
+	}
+	withContextReturns struct {
+		result1 client.SessionClient
+	}
+	withContextReturnsOnCall map[int]struct {
+		result1 client.SessionClient
+	}
 	WithDebugStub        func(io.Writer) client.SessionClient
 	withDebugMutex       sync.RWMutex
 	withDebugArgsForCall []struct {
@@ -66,6 +78,17 @@ type SessionClient struct {
 	withQueryValueReturnsOnCall map[int]struct {
 		result1 client.SessionClient
 	}
+	WithTokenSourceStub        func(client.TokenSource) client.SessionClient
+	withTokenSourceMutex       sync.RWMutex
+	withTokenSourceArgsForCall []struct {
+		arg1 client.TokenSource
+	}
+	withTokenSourceReturns struct {
+		result1 client.SessionClient
+	}
+	withTokenSourceReturnsOnCall map[int]struct {
+		result1 client.SessionClient
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -190,6 +213,66 @@ func (fake *SessionClient) WithAuthTokenReturnsOnCall(i int, result1 client.Sess
 	}{result1}
 }
 
+func (fake *SessionClient) WithContext(arg1 context.Context) client.SessionClient {
+	fake.withContextMutex.Lock()
+	ret, specificReturn := fake.withContextReturnsOnCall[len(fake.withContextArgsForCall)]
+	fake.withContextArgsForCall = append(fake.withContextArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	fake.recordInvocation("WithContext", []interface{}{arg1})
+	fake.withContextMutex.Unlock()
+	if fake.WithContextStub != nil {
+		return fake.WithContextStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.withContextReturns
+	return fakeReturns.result1
+}
+
+func (fake *SessionClient) WithContextCallCount() int {
+	fake.withContextMutex.RLock()
+	defer fake.withContextMutex.RUnlock()
+	return len(fake.withContextArgsForCall)
+}
+
+func (fake *SessionClient) WithContextCalls(stub func(context.Context) client.SessionClient) {
+	fake.withContextMutex.Lock()
+	defer fake.withContextMutex.Unlock()
+	fake.WithContextStub = stub
+}
+
+func (fake *SessionClient) WithContextArgsForCall(i int) context.Context {
+	fake.withContextMutex.RLock()
+	defer fake.withContextMutex.RUnlock()
+	argsForCall := fake.withContextArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *SessionClient) WithContextReturns(result1 client.SessionClient) {
+	fake.withContextMutex.Lock()
+	defer fake.withContextMutex.Unlock()
+	fake.WithContextStub = nil
+	fake.withContextReturns = struct {
+		result1 client.SessionClient
+	}{result1}
+}
+
+func (fake *SessionClient) WithContextReturnsOnCall(i int, result1 client.SessionClient) {
+	fake.withContextMutex.Lock()
+	defer fake.withContextMutex.Unlock()
+	fake.WithContextStub = nil
+	if fake.withContextReturnsOnCall == nil {
+		fake.withContextReturnsOnCall = make(map[int]struct {
+			result1 client.SessionClient
+		})
+	}
+	fake.withContextReturnsOnCall[i] = struct {
+		result1 client.SessionClient
+	}{result1}
+}
+
 func (fake *SessionClient) WithDebug(arg1 io.Writer) client.SessionClient {
 	fake.withDebugMutex.Lock()
 	ret, specificReturn := fake.withDebugReturnsOnCall[len(fake.withDebugArgsForCall)]
@@ -372,6 +455,66 @@ func (fake *SessionClient) WithQueryValueReturnsOnCall(i int, result1 client.Ses
 	}{result1}
 }
 
+func (fake *SessionClient) WithTokenSource(arg1 client.TokenSource) client.SessionClient {
+	fake.withTokenSourceMutex.Lock()
+	ret, specificReturn := fake.withTokenSourceReturnsOnCall[len(fake.withTokenSourceArgsForCall)]
+	fake.withTokenSourceArgsForCall = append(fake.withTokenSourceArgsForCall, struct {
+		arg1 client.TokenSource
+	}{arg1})
+	fake.recordInvocation("WithTokenSource", []interface{}{arg1})
+	fake.withTokenSourceMutex.Unlock()
+	if fake.WithTokenSourceStub != nil {
+		return fake.WithTokenSourceStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.withTokenSourceReturns
+	return fakeReturns.result1
+}
+
+func (fake *SessionClient) WithTokenSourceCallCount() int {
+	fake.withTokenSourceMutex.RLock()
+	defer fake.withTokenSourceMutex.RUnlock()
+	return len(fake.withTokenSourceArgsForCall)
+}
+
+func (fake *SessionClient) WithTokenSourceCalls(stub func(client.TokenSource) client.SessionClient) {
+	fake.withTokenSourceMutex.Lock()
+	defer fake.withTokenSourceMutex.Unlock()
+	fake.WithTokenSourceStub = stub
+}
+
+func (fake *SessionClient) WithTokenSourceArgsForCall(i int) client.TokenSource {
+	fake.withTokenSourceMutex.RLock()
+	defer fake.withTokenSourceMutex.RUnlock()
+	argsForCall := fake.withTokenSourceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *SessionClient) WithTokenSourceReturns(result1 client.SessionClient) {
+	fake.withTokenSourceMutex.Lock()
+	defer fake.withTokenSourceMutex.Unlock()
+	fake.WithTokenSourceStub = nil
+	fake.withTokenSourceReturns = struct {
+		result1 client.SessionClient
+	}{result1}
+}
+
+func (fake *SessionClient) WithTokenSourceReturnsOnCall(i int, result1 client.SessionClient) {
+	fake.withTokenSourceMutex.Lock()
+	defer fake.withTokenSourceMutex.Unlock()
+	fake.WithTokenSourceStub = nil
+	if fake.withTokenSourceReturnsOnCall == nil {
+		fake.withTokenSourceReturnsOnCall = make(map[int]struct {
+			result1 client.SessionClient
+		})
+	}
+	fake.withTokenSourceReturnsOnCall[i] = struct {
+		result1 client.SessionClient
+	}{result1}
+}
+
 func (fake *SessionClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -379,12 +522,16 @@ func (fake *SessionClient) Invocations() map[string][][]interface{} {
 	defer fake.newRequestMutex.RUnlock()
 	fake.withAuthTokenMutex.RLock()
 	defer fake.withAuthTokenMutex.RUnlock()
+	fake.withContextMutex.RLock()
+	defer fake.withContextMutex.RUnlock()
 	fake.withDebugMutex.RLock()
 	defer fake.withDebugMutex.RUnlock()
 	fake.withPathParameterMutex.RLock()
 	defer fake.withPathParameterMutex.RUnlock()
 	fake.withQueryValueMutex.RLock()
 	defer fake.withQueryValueMutex.RUnlock()
+	fake.withTokenSourceMutex.RLock()
+	defer fake.withTokenSourceMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
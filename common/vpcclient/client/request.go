@@ -26,10 +26,12 @@ import (
 	"net/url"
 	"reflect"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/client/payload"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
 	"github.com/fatih/structs"
 )
 
@@ -69,6 +71,12 @@ type BodyProvider interface {
 	Body() (io.Reader, error)
 }
 
+// HTTPStatusError is implemented by the error receivers which keep the HTTP status of the failed response
+type HTTPStatusError interface {
+	error
+	SetHTTPStatus(statusCode int, retryAfter time.Duration)
+}
+
 // ResponseConsumer ...
 type ResponseConsumer interface {
 	Consume(io.Reader) error
@@ -164,8 +172,44 @@ func (r *Request) JSONError(receiver error) *Request {
 	return r
 }
 
-// Invoke performs the request, and populates the response or error as appropriate
+// Invoke performs the request, and populates the response or error as appropriate. The request rejected for
+// its authentication token is sent once again if the token can be renewed
 func (r *Request) Invoke() (*http.Response, error) {
+	resp, err := r.invoke()
+	if !isAuthenticationFailure(resp, err) {
+		return resp, err
+	}
+
+	// The multipart body can not be read again
+	if _, multipart := r.bodyProvider.(*payload.MultipartFileBody); multipart {
+		return resp, err
+	}
+
+	refreshed, refreshErr := r.authenHandler.Refresh(r)
+	if refreshErr != nil || !refreshed {
+		return resp, err
+	}
+	r.debugf("\nAuthentication token is renewed, sending the request again\n")
+	return r.invoke()
+}
+
+// isAuthenticationFailure tells if the request is rejected for its authentication token
+func isAuthenticationFailure(resp *http.Response, err error) bool {
+	if resp != nil && resp.StatusCode == http.StatusUnauthorized {
+		return true
+	}
+	if apiErr, ok := err.(*models.Error); ok {
+		for _, errorItem := range apiErr.Errors {
+			if errorItem.Code == models.ErrorCodeTokenInvalid {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// invoke sends the request once
+func (r *Request) invoke() (*http.Response, error) {
 	err := r.authenHandler.Before(r)
 	if err != nil {
 		return nil, err
@@ -214,7 +258,15 @@ func (r *Request) Invoke() (*http.Response, error) {
 	default:
 		if r.errorConsumer != nil {
 			err = r.errorConsumer.Consume(resp.Body)
-			if err == nil {
+			if statusErr, ok := r.errorConsumer.Receiver().(HTTPStatusError); ok {
+				// The status is returned even if the body is not the expected error, e.g. the page of a throttling
+				// proxy, so that the failure can be retried as per the status and the Retry-After header
+				if err != nil {
+					r.debugf("Error decoding the error response\n%s\n", err)
+				}
+				statusErr.SetHTTPStatus(resp.StatusCode, parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()))
+				err = statusErr
+			} else if err == nil {
 				err = r.errorConsumer.Receiver().(error)
 			}
 		}
@@ -223,6 +275,24 @@ func (r *Request) Invoke() (*http.Response, error) {
 	return resp, err
 }
 
+// parseRetryAfter returns the wait of the Retry-After header, given either in seconds or as HTTP date
+func parseRetryAfter(retryAfter string, now time.Time) time.Duration {
+	retryAfter = strings.TrimSpace(retryAfter)
+	if retryAfter == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(retryAfter); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(retryAfter); err == nil && date.After(now) {
+		return date.Sub(now)
+	}
+	return 0
+}
+
 func (r *Request) debugRequest(req *http.Request) {
 	if r.debugWriter == nil {
 		return
@@ -256,6 +326,9 @@ func (r *Request) debugResponse(resp *http.Response) {
 }
 
 func (r *Request) debugf(format string, args ...interface{}) {
+	if r.debugWriter == nil {
+		return
+	}
 	fmt.Fprintf(r.debugWriter, format, args...)
 }
 
@@ -25,6 +25,9 @@ const (
 	instanceIDvolumeAttachmentPath = instanceIDPath + "/" + volumeAttachmentPath
 	instanceIDattachmentIDPath     = instanceIDvolumeAttachmentPath + "/{" + attachmentIDParam + "}"
 
+	// InstanceStatusRunning is the status of an instance to which volumes can be attached
+	InstanceStatusRunning = "running"
+
 	// VpcPathPrefix  VPC URL path prefix
 	VpcPathPrefix = "v1/instances"
 
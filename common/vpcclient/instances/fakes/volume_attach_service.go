@@ -2,6 +2,7 @@
 package fakes
 
 import (
+	"context"
 	"net/http"
 	"sync"
 
@@ -67,6 +68,17 @@ type VolumeAttachService struct {
 		result1 *models.VolumeAttachmentList
 		result2 error
 	}
+	WithContextStub        func(context.Context) instances.VolumeAttachManager
+	withContextMutex       sync.RWMutex
+	withContextArgsForCall []struct {
+		arg1 context.Context
+	}
+	withContextReturns struct {
+		result1 instances.VolumeAttachManager
+	}
+	withContextReturnsOnCall map[int]struct {
+		result1 instances.VolumeAttachManager
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -327,6 +339,66 @@ func (fake *VolumeAttachService) ListVolumeAttachmentsReturnsOnCall(i int, resul
 	}{result1, result2}
 }
 
+func (fake *VolumeAttachService) WithContext(arg1 context.Context) instances.VolumeAttachManager {
+	fake.withContextMutex.Lock()
+	ret, specificReturn := fake.withContextReturnsOnCall[len(fake.withContextArgsForCall)]
+	fake.withContextArgsForCall = append(fake.withContextArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	fake.recordInvocation("WithContext", []interface{}{arg1})
+	fake.withContextMutex.Unlock()
+	if fake.WithContextStub != nil {
+		return fake.WithContextStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.withContextReturns
+	return fakeReturns.result1
+}
+
+func (fake *VolumeAttachService) WithContextCallCount() int {
+	fake.withContextMutex.RLock()
+	defer fake.withContextMutex.RUnlock()
+	return len(fake.withContextArgsForCall)
+}
+
+func (fake *VolumeAttachService) WithContextCalls(stub func(context.Context) instances.VolumeAttachManager) {
+	fake.withContextMutex.Lock()
+	defer fake.withContextMutex.Unlock()
+	fake.WithContextStub = stub
+}
+
+func (fake *VolumeAttachService) WithContextArgsForCall(i int) context.Context {
+	fake.withContextMutex.RLock()
+	defer fake.withContextMutex.RUnlock()
+	argsForCall := fake.withContextArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *VolumeAttachService) WithContextReturns(result1 instances.VolumeAttachManager) {
+	fake.withContextMutex.Lock()
+	defer fake.withContextMutex.Unlock()
+	fake.WithContextStub = nil
+	fake.withContextReturns = struct {
+		result1 instances.VolumeAttachManager
+	}{result1}
+}
+
+func (fake *VolumeAttachService) WithContextReturnsOnCall(i int, result1 instances.VolumeAttachManager) {
+	fake.withContextMutex.Lock()
+	defer fake.withContextMutex.Unlock()
+	fake.WithContextStub = nil
+	if fake.withContextReturnsOnCall == nil {
+		fake.withContextReturnsOnCall = make(map[int]struct {
+			result1 instances.VolumeAttachManager
+		})
+	}
+	fake.withContextReturnsOnCall[i] = struct {
+		result1 instances.VolumeAttachManager
+	}{result1}
+}
+
 func (fake *VolumeAttachService) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -338,6 +410,8 @@ func (fake *VolumeAttachService) Invocations() map[string][][]interface{} {
 	defer fake.getVolumeAttachmentMutex.RUnlock()
 	fake.listVolumeAttachmentsMutex.RLock()
 	defer fake.listVolumeAttachmentsMutex.RUnlock()
+	fake.withContextMutex.RLock()
+	defer fake.withContextMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
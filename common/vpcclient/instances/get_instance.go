@@ -0,0 +1,56 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package instances ...
+package instances
+
+import (
+	"time"
+
+	"github.com/IBM/ibmcloud-volume-interface/lib/metrics"
+	util "github.com/IBM/ibmcloud-volume-interface/lib/utils"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/client"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
+	"go.uber.org/zap"
+)
+
+// GetInstance GETs /instances/{instance-id}
+func (is *InstanceService) GetInstance(instanceID string, ctxLogger *zap.Logger) (*models.Instance, error) {
+	methodName := "InstanceService.GetInstance"
+	defer util.TimeTracker(methodName, time.Now())
+	defer metrics.UpdateDurationFromStart(ctxLogger, methodName, time.Now())
+
+	operation := &client.Operation{
+		Name:        "GetInstance",
+		Method:      "GET",
+		PathPattern: is.pathPrefix + instanceIDPath,
+	}
+
+	var instance models.Instance
+	var apiErr models.Error
+
+	request := is.client.NewRequest(operation)
+	ctxLogger.Info("Equivalent curl command details", zap.Reflect("URL", request.URL()), zap.Reflect("Operation", operation))
+	ctxLogger.Info("Pathparameters", zap.Reflect(instanceIDParam, instanceID))
+
+	req := request.PathParameter(instanceIDParam, instanceID)
+	_, err := req.JSONSuccess(&instance).JSONError(&apiErr).Invoke()
+	if err != nil {
+		ctxLogger.Error("Error occurred while getting instance", zap.Error(err))
+		return nil, err
+	}
+	ctxLogger.Info("Successfully retrieved the instance", zap.Reflect("instance", instance))
+	return &instance, nil
+}
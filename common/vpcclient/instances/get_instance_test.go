@@ -0,0 +1,91 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package instances_test ...
+package instances_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/instances"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/riaas/test"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestGetInstance(t *testing.T) {
+	// Setup new style zap logger
+	logger, _ := GetTestContextLogger()
+	defer logger.Sync()
+
+	testCases := []struct {
+		name string
+
+		// Response
+		status  int
+		content string
+
+		// Expected return
+		expectErr string
+		verify    func(*testing.T, *models.Instance)
+	}{
+		{
+			name:    "Verify that the instance zone and status are parsed",
+			status:  http.StatusOK,
+			content: "{\"id\":\"testinstance\",\"name\":\"test-instance\",\"status\":\"running\",\"zone\":{\"name\":\"us-south-1\"}}",
+			verify: func(t *testing.T, instance *models.Instance) {
+				assert.Equal(t, "testinstance", instance.ID)
+				assert.Equal(t, instances.InstanceStatusRunning, instance.Status)
+				if assert.NotNil(t, instance.Zone) {
+					assert.Equal(t, "us-south-1", instance.Zone.Name)
+				}
+			},
+		}, {
+			name:      "Verify that a 404 is returned to the caller",
+			status:    http.StatusNotFound,
+			content:   "{\"errors\":[{\"message\":\"testerr\"}]}",
+			expectErr: "Trace Code:, testerr Please check ",
+		},
+	}
+
+	for _, testcase := range testCases {
+		t.Run(testcase.name, func(t *testing.T) {
+			mux, client, teardown := test.SetupServer(t)
+			test.SetupMuxResponse(t, mux, "/v1/instances/testinstance", http.MethodGet, nil, testcase.status, testcase.content, nil)
+
+			defer teardown()
+
+			logger.Info("Test case being executed", zap.Reflect("testcase", testcase.name))
+
+			instanceService := instances.NewInstanceManager(client)
+
+			instance, err := instanceService.GetInstance("testinstance", logger)
+
+			if testcase.expectErr != "" && assert.Error(t, err) {
+				assert.Equal(t, testcase.expectErr, err.Error())
+				assert.Nil(t, instance)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, instance)
+			}
+
+			if testcase.verify != nil {
+				testcase.verify(t, instance)
+			}
+		})
+	}
+}
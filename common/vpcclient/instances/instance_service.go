@@ -0,0 +1,46 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package instances ...
+package instances
+
+import (
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/client"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
+	"go.uber.org/zap"
+)
+
+// InstanceManager operations
+//go:generate counterfeiter -o fakes/instance_service.go --fake-name InstanceService . InstanceManager
+type InstanceManager interface {
+	// GetInstance retrieves the instance, including its zone and status, by using the instance ID
+	GetInstance(instanceID string, ctxLogger *zap.Logger) (*models.Instance, error)
+}
+
+// InstanceService ...
+type InstanceService struct {
+	client     client.SessionClient
+	pathPrefix string
+}
+
+var _ InstanceManager = &InstanceService{}
+
+// NewInstanceManager ...
+func NewInstanceManager(clientIn client.SessionClient) InstanceManager {
+	return &InstanceService{
+		client:     clientIn,
+		pathPrefix: VpcPathPrefix,
+	}
+}
@@ -18,6 +18,7 @@
 package instances
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/client"
@@ -36,6 +37,8 @@ type VolumeAttachManager interface {
 	ListVolumeAttachments(*models.VolumeAttachment, *zap.Logger) (*models.VolumeAttachmentList, error)
 	// Delete the volume
 	DetachVolume(*models.VolumeAttachment, *zap.Logger) (*http.Response, error)
+	// WithContext returns a copy of the manager whose requests are sent with the supplied context
+	WithContext(ctx context.Context) VolumeAttachManager
 }
 
 // VolumeAttachService ...
@@ -80,3 +83,17 @@ func NewIKSVolumeAttachmentManager(clientIn client.SessionClient) VolumeAttachMa
 		receiverError: &err,
 	}
 }
+
+// WithContext returns a copy of the VolumeAttachService whose requests are sent with the supplied context
+func (vs *VolumeAttachService) WithContext(ctx context.Context) VolumeAttachManager {
+	contextService := *vs
+	contextService.client = vs.client.WithContext(ctx)
+	return &contextService
+}
+
+// WithContext returns a copy of the IKSVolumeAttachService whose requests are sent with the supplied context
+func (vs *IKSVolumeAttachService) WithContext(ctx context.Context) VolumeAttachManager {
+	contextService := *vs
+	contextService.client = vs.client.WithContext(ctx)
+	return &contextService
+}
@@ -19,6 +19,7 @@ package models
 
 import (
 	"fmt"
+	"time"
 )
 
 // ErrorType ...
@@ -49,6 +50,10 @@ const (
 type Error struct {
 	Errors []ErrorItem `json:"errors"`
 	Trace  string      `json:"trace,omitempty"`
+
+	// HTTP status of the failed response, these are not part of the response body
+	StatusCode int           `json:"-"`
+	RetryAfter time.Duration `json:"-"` // wait requested by the server through the Retry-After header
 }
 
 // ErrorItem ...
@@ -70,9 +75,18 @@ func (e Error) Error() string {
 		return "Trace Code:" + e.Trace + ", " + e.Errors[0].Error()
 	}
 
+	if e.StatusCode != 0 {
+		return fmt.Sprintf("Unknown error, HTTP status code %d", e.StatusCode)
+	}
 	return "Unknown error"
 }
 
+// SetHTTPStatus keeps the HTTP status of the failed response
+func (e *Error) SetHTTPStatus(statusCode int, retryAfter time.Duration) {
+	e.StatusCode = statusCode
+	e.RetryAfter = retryAfter
+}
+
 // ErrorTarget ...
 type ErrorTarget struct {
 	Name string    `json:"name,omitempty"`
@@ -88,9 +102,19 @@ type IksError struct {
 	RecoveryCLI string    `json:"recoveryCLI,omitempty"`
 	RecoveryUI  string    `json:"recoveryUI,omitempty"`
 	RC          int       `json:"rc,omitempty"`
+
+	// HTTP status of the failed response, these are not part of the response body
+	StatusCode int           `json:"-"`
+	RetryAfter time.Duration `json:"-"` // wait requested by the server through the Retry-After header
 }
 
 // Error ...
 func (ikserr IksError) Error() string {
 	return fmt.Sprintf("%s: %s", ikserr.Code, ikserr.Err)
 }
+
+// SetHTTPStatus keeps the HTTP status of the failed response
+func (ikserr *IksError) SetHTTPStatus(statusCode int, retryAfter time.Duration) {
+	ikserr.StatusCode = statusCode
+	ikserr.RetryAfter = retryAfter
+}
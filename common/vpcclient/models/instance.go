@@ -0,0 +1,30 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package models ...
+package models
+
+// Instance ...
+type Instance struct {
+	ID   string `json:"id,omitempty"`
+	Href string `json:"href,omitempty"`
+	Name string `json:"name,omitempty"`
+	CRN  string `json:"crn,omitempty"`
+
+	Status  string   `json:"status,omitempty"`
+	Zone    *Zone    `json:"zone,omitempty"`
+	Profile *Profile `json:"profile,omitempty"`
+}
@@ -23,3 +23,8 @@ type ResourceGroup struct {
 	ID   string `json:"id,omitempty"`
 	Name string `json:"name,omitempty"`
 }
+
+// ResourceGroupList ...
+type ResourceGroupList struct {
+	Resources []*ResourceGroup `json:"resources"`
+}
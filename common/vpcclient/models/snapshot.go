@@ -19,6 +19,11 @@ package models
 
 import "time"
 
+const (
+	// SnapshotNameAttribute is the volume attribute holding the name of the snapshot to create
+	SnapshotNameAttribute = "snapshot_name"
+)
+
 // Snapshot ...
 type Snapshot struct {
 	Href          string         `json:"href,omitempty"`
@@ -29,9 +34,23 @@ type Snapshot struct {
 	CreatedAt     *time.Time     `json:"created_at,omitempty"`
 	Status        StatusType     `json:"status,omitempty"`
 	Tags          []string       `json:"tags,omitempty"`
+	Size          int64          `json:"size,omitempty"`
+	SourceVolume  *Volume        `json:"source_volume,omitempty"`
 }
 
 // SnapshotList ...
 type SnapshotList struct {
-	Snapshots []*Snapshot `json:"snapshot,omitempty"`
+	First      *HReference `json:"first,omitempty"`
+	Next       *HReference `json:"next,omitempty"`
+	Snapshots  []*Snapshot `json:"snapshots,omitempty"`
+	Limit      int         `json:"limit,omitempty"`
+	TotalCount int         `json:"total_count,omitempty"`
+}
+
+// ListSnapshotFilters ...
+type ListSnapshotFilters struct {
+	ResourceGroupID string `json:"resource_group.id,omitempty"`
+	Tag             string `json:"tag,omitempty"`
+	SnapshotName    string `json:"name,omitempty"`
+	SourceVolumeID  string `json:"source_volume.id,omitempty"`
 }
@@ -29,6 +29,10 @@ const (
 	ClusterIDTagName = "clusterid"
 	//VolumeStatus ...
 	VolumeStatus = "status"
+	//SourceSnapshotID attribute holding the ID of the snapshot the volume was created from
+	SourceSnapshotID = "source_snapshot_id"
+	//AttachmentInstanceIDPrefix prefixes the attribute holding the instance ID of the volume attachment, followed by the attachment ID
+	AttachmentInstanceIDPrefix = "attachment_instance_id."
 )
 
 // Volume ...
@@ -54,6 +58,13 @@ type Volume struct {
 	VolumeType string `json:"volume_type,omitempty"`
 }
 
+// VolumePatch holds the attributes which can be changed on an existing volume
+type VolumePatch struct {
+	Capacity int64    `json:"capacity,omitempty"`
+	Iops     int64    `json:"iops,omitempty"`
+	Profile  *Profile `json:"profile,omitempty"`
+}
+
 // ListVolumeFilters ...
 type ListVolumeFilters struct {
 	ResourceGroupID string `json:"resource_group.id,omitempty"`
@@ -81,6 +92,7 @@ func NewVolume(volumeRequest provider.Volume) Volume {
 	// Build the template to send to backend
 
 	volume := Volume{
+		Href: volumeRequest.VPCVolume.Href,
 		ID:   volumeRequest.VolumeID,
 		CRN:  volumeRequest.CRN,
 		Tags: volumeRequest.VPCVolume.Tags,
@@ -98,15 +110,46 @@ func NewVolume(volumeRequest provider.Volume) Volume {
 	}
 	if volumeRequest.VPCVolume.Profile != nil {
 		volume.Profile = &Profile{
+			CRN:  volumeRequest.VPCVolume.Profile.CRN,
+			Href: volumeRequest.VPCVolume.Profile.Href,
 			Name: volumeRequest.VPCVolume.Profile.Name,
 		}
 	}
 	if volumeRequest.VPCVolume.ResourceGroup != nil {
 		volume.ResourceGroup = &ResourceGroup{
+			Href: volumeRequest.VPCVolume.ResourceGroup.Href,
 			ID:   volumeRequest.VPCVolume.ResourceGroup.ID,
 			Name: volumeRequest.VPCVolume.ResourceGroup.Name,
 		}
 	}
+	if !volumeRequest.CreationTime.IsZero() {
+		createdAt := volumeRequest.CreationTime
+		volume.CreatedAt = &createdAt
+	}
+	if volumeRequest.VPCVolume.VolumeAttachments != nil {
+		volumeAttachments := make([]VolumeAttachment, 0, len(*volumeRequest.VPCVolume.VolumeAttachments))
+		for _, attachment := range *volumeRequest.VPCVolume.VolumeAttachments {
+			volumeAttachment := VolumeAttachment{
+				Href:                         attachment.Href,
+				ID:                           attachment.ID,
+				Name:                         attachment.Name,
+				Type:                         attachment.Type,
+				DeleteVolumeOnInstanceDelete: attachment.DeleteVolumeOnInstanceDelete,
+			}
+			if instanceID := volumeRequest.Attributes[AttachmentInstanceIDPrefix+attachment.ID]; instanceID != "" {
+				volumeAttachment.InstanceID = &instanceID
+				volumeAttachment.Instance = &Instance{ID: instanceID}
+			}
+			if len(attachment.DevicePath) > 0 {
+				volumeAttachment.Device = &Device{ID: attachment.DevicePath}
+			}
+			volumeAttachments = append(volumeAttachments, volumeAttachment)
+		}
+		volume.VolumeAttachments = &volumeAttachments
+	}
+	if snapshotID := volumeRequest.Attributes[SourceSnapshotID]; snapshotID != "" {
+		volume.Snapshot = &Snapshot{ID: snapshotID}
+	}
 
 	if volumeRequest.Iops != nil {
 		value, err := strconv.ParseInt(*volumeRequest.Iops, 10, 64)
@@ -124,3 +167,88 @@ func NewVolume(volumeRequest provider.Volume) Volume {
 	volume.Status = StatusType(volumeRequest.Attributes[VolumeStatus])
 	return volume
 }
+
+//NewProviderVolume creates provider volume from model volume, it is the reverse of NewVolume
+func NewProviderVolume(volume Volume) provider.Volume {
+	volumeCap := int(volume.Capacity)
+	iops := strconv.FormatInt(volume.Iops, 10)
+
+	volumeResponse := provider.Volume{
+		VolumeID:   volume.ID,
+		Provider:   provider.VolumeProvider(volume.Provider),
+		VolumeType: provider.VolumeType(volume.VolumeType),
+		Capacity:   &volumeCap,
+		Iops:       &iops,
+		VPCVolume: provider.VPCVolume{
+			Href: volume.Href,
+			Tags: volume.Tags,
+			CRN:  volume.CRN,
+		},
+	}
+	if len(volume.Name) > 0 {
+		name := volume.Name
+		volumeResponse.Name = &name
+	}
+	if volume.Zone != nil {
+		volumeResponse.Az = volume.Zone.Name
+	}
+	if volume.CreatedAt != nil {
+		volumeResponse.CreationTime = *volume.CreatedAt
+	}
+	if volume.Profile != nil {
+		volumeResponse.VPCVolume.Profile = &provider.Profile{
+			CRN:  volume.Profile.CRN,
+			Href: volume.Profile.Href,
+			Name: volume.Profile.Name,
+		}
+	}
+	if volume.ResourceGroup != nil {
+		volumeResponse.VPCVolume.ResourceGroup = &provider.ResourceGroup{
+			Href: volume.ResourceGroup.Href,
+			ID:   volume.ResourceGroup.ID,
+			Name: volume.ResourceGroup.Name,
+		}
+	}
+	if volume.VolumeEncryptionKey != nil && len(volume.VolumeEncryptionKey.CRN) > 0 {
+		volumeResponse.VPCVolume.VolumeEncryptionKey = &provider.VolumeEncryptionKey{CRN: volume.VolumeEncryptionKey.CRN}
+	}
+	// Attributes which have no dedicated field in provider volume
+	attributes := map[string]string{}
+	if volume.VolumeAttachments != nil {
+		volumeAttachments := make([]provider.VolumeAttachment, 0, len(*volume.VolumeAttachments))
+		for _, attachment := range *volume.VolumeAttachments {
+			volumeAttachment := provider.VolumeAttachment{
+				Href:                         attachment.Href,
+				ID:                           attachment.ID,
+				Name:                         attachment.Name,
+				Type:                         attachment.Type,
+				DeleteVolumeOnInstanceDelete: attachment.DeleteVolumeOnInstanceDelete,
+			}
+			// The attachments of a volume refer to the instance, the attachment requests carry the instance ID
+			if attachment.Instance != nil && len(attachment.Instance.ID) > 0 {
+				attributes[AttachmentInstanceIDPrefix+attachment.ID] = attachment.Instance.ID
+			} else if attachment.InstanceID != nil && len(*attachment.InstanceID) > 0 {
+				attributes[AttachmentInstanceIDPrefix+attachment.ID] = *attachment.InstanceID
+			}
+			// The device path depends on the generation of the instance, so the device ID is kept as is
+			if attachment.Device != nil {
+				volumeAttachment.DevicePath = attachment.Device.ID
+			}
+			volumeAttachments = append(volumeAttachments, volumeAttachment)
+		}
+		volumeResponse.VPCVolume.VolumeAttachments = &volumeAttachments
+	}
+	if len(volume.Status) > 0 {
+		attributes[VolumeStatus] = string(volume.Status)
+	}
+	if len(volume.Cluster) > 0 {
+		attributes[ClusterIDTagName] = volume.Cluster
+	}
+	if volume.Snapshot != nil && len(volume.Snapshot.ID) > 0 {
+		attributes[SourceSnapshotID] = volume.Snapshot.ID
+	}
+	if len(attributes) > 0 {
+		volumeResponse.Attributes = attributes
+	}
+	return volumeResponse
+}
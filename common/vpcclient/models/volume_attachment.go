@@ -44,6 +44,8 @@ type VolumeAttachment struct {
 	CreatedAt  *time.Time `json:"created_at,omitempty"`
 	// If set to true, when deleting the instance the volume will also be deleted
 	DeleteVolumeOnInstanceDelete bool `json:"delete_volume_on_instance_delete,omitempty"`
+	// Instance reference returned with the attachments of a volume
+	Instance *Instance `json:"instance,omitempty"`
 }
 
 // VolumeAttachmentList ...
@@ -0,0 +1,52 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package models ...
+package models
+
+// Volume profile range types
+const (
+	ProfileRangeTypeFixed          = "fixed"
+	ProfileRangeTypeRange          = "range"
+	ProfileRangeTypeDependent      = "dependent"
+	ProfileRangeTypeDependentRange = "dependent_range"
+)
+
+// VolumeProfile describes a volume profile and the capacity and IOPS it supports
+type VolumeProfile struct {
+	Href     string        `json:"href,omitempty"`
+	Name     string        `json:"name,omitempty"`
+	Family   string        `json:"family,omitempty"`
+	Capacity *ProfileRange `json:"capacity,omitempty"`
+	Iops     *ProfileRange `json:"iops,omitempty"`
+}
+
+// ProfileRange is the capacity (in GB) or IOPS supported by a volume profile
+type ProfileRange struct {
+	Type  string `json:"type,omitempty"`
+	Value int64  `json:"value,omitempty"`
+	Min   int64  `json:"min,omitempty"`
+	Max   int64  `json:"max,omitempty"`
+	Step  int64  `json:"step,omitempty"`
+}
+
+// VolumeProfileList ...
+type VolumeProfileList struct {
+	First      *HReference      `json:"first,omitempty"`
+	Next       *HReference      `json:"next,omitempty"`
+	Profiles   []*VolumeProfile `json:"profiles"`
+	Limit      int              `json:"limit,omitempty"`
+	TotalCount int              `json:"total_count,omitempty"`
+}
@@ -19,6 +19,13 @@ package models
 
 // Zone ...
 type Zone struct {
-	Name string `json:"name,omitempty"`
-	Href string `json:"href,omitempty"`
+	Name   string  `json:"name,omitempty"`
+	Href   string  `json:"href,omitempty"`
+	Status string  `json:"status,omitempty"`
+	Region *Region `json:"region,omitempty"`
+}
+
+// ZoneList ...
+type ZoneList struct {
+	Zones []*Zone `json:"zones"`
 }
@@ -0,0 +1,62 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package regions ...
+package regions
+
+import (
+	"time"
+
+	util "github.com/IBM/ibmcloud-volume-interface/lib/utils"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/client"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
+	"go.uber.org/zap"
+)
+
+// ListRegions GETs /regions. The result is cached by the service
+func (rs *RegionService) ListRegions(ctxLogger *zap.Logger) (*models.RegionList, error) {
+	ctxLogger.Debug("Entry Backend ListRegions")
+	defer ctxLogger.Debug("Exit Backend ListRegions")
+
+	defer util.TimeTracker("ListRegions", time.Now())
+
+	rs.mutex.Lock()
+	defer rs.mutex.Unlock()
+
+	if rs.regions != nil {
+		ctxLogger.Info("Returning cached regions")
+		return rs.regions, nil
+	}
+
+	operation := &client.Operation{
+		Name:        "ListRegions",
+		Method:      "GET",
+		PathPattern: regionsPath,
+	}
+
+	var regions models.RegionList
+	var apiErr models.Error
+
+	request := rs.client.NewRequest(operation)
+	ctxLogger.Info("Equivalent curl command", zap.Reflect("URL", request.URL()), zap.Reflect("Operation", operation))
+
+	_, err := request.JSONSuccess(&regions).JSONError(&apiErr).Invoke()
+	if err != nil {
+		return nil, err
+	}
+
+	rs.regions = &regions
+	return &regions, nil
+}
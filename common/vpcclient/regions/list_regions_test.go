@@ -0,0 +1,104 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package regions_test ...
+package regions_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/regions"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/riaas/test"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestListRegions(t *testing.T) {
+	// Setup new style zap logger
+	logger, _ := GetTestContextLogger()
+	defer logger.Sync()
+
+	testCases := []struct {
+		name string
+
+		// Response
+		status  int
+		content string
+
+		// Expected return
+		expectErr string
+		verify    func(*testing.T, *models.RegionList)
+	}{
+		{
+			name:   "Verify that the correct endpoint is invoked",
+			status: http.StatusNoContent,
+		}, {
+			name:      "Verify that a 404 is returned to the caller",
+			status:    http.StatusNotFound,
+			content:   "{\"errors\":[{\"message\":\"testerr\"}]}",
+			expectErr: "Trace Code:, testerr Please check ",
+		}, {
+			name:    "Verify that the region list is parsed",
+			status:  http.StatusOK,
+			content: "{\"regions\":[{\"name\":\"us-south\",\"endpoint\":\"https://us-south.iaas.cloud.ibm.com\",\"status\":\"available\"}]}",
+			verify: func(t *testing.T, regions *models.RegionList) {
+				assert.Equal(t, 1, len(regions.Regions))
+				assert.Equal(t, "us-south", regions.Regions[0].Name)
+				assert.Equal(t, "https://us-south.iaas.cloud.ibm.com", regions.Regions[0].Endpoint)
+			},
+		},
+	}
+
+	for _, testcase := range testCases {
+		t.Run(testcase.name, func(t *testing.T) {
+			mux, client, teardown := test.SetupServer(t)
+			requestCount := 0
+			test.SetupMuxResponse(t, mux, regions.Version+"/regions", http.MethodGet, nil, testcase.status, testcase.content, func(t *testing.T, r *http.Request) {
+				requestCount++
+			})
+
+			defer teardown()
+
+			logger.Info("Test case being executed", zap.Reflect("testcase", testcase.name))
+
+			regionService := regions.New(client)
+
+			regionList, err := regionService.ListRegions(logger)
+			logger.Info("Regions", zap.Reflect("regions", regionList))
+
+			if testcase.expectErr != "" && assert.Error(t, err) {
+				assert.Equal(t, testcase.expectErr, err.Error())
+				assert.Nil(t, regionList)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, regionList)
+			}
+
+			if testcase.verify != nil {
+				testcase.verify(t, regionList)
+			}
+
+			// Successful lookups are served from the cache of the service
+			_, _ = regionService.ListRegions(logger)
+			if err == nil {
+				assert.Equal(t, 1, requestCount)
+			} else {
+				assert.Equal(t, 2, requestCount)
+			}
+		})
+	}
+}
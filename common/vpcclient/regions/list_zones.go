@@ -0,0 +1,63 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package regions ...
+package regions
+
+import (
+	"time"
+
+	util "github.com/IBM/ibmcloud-volume-interface/lib/utils"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/client"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
+	"go.uber.org/zap"
+)
+
+// ListZones GETs /regions/{region-name}/zones. The result is cached per region by the service
+func (rs *RegionService) ListZones(regionName string, ctxLogger *zap.Logger) (*models.ZoneList, error) {
+	ctxLogger.Debug("Entry Backend ListZones")
+	defer ctxLogger.Debug("Exit Backend ListZones")
+
+	defer util.TimeTracker("ListZones", time.Now())
+
+	rs.mutex.Lock()
+	defer rs.mutex.Unlock()
+
+	if zones, ok := rs.zones[regionName]; ok {
+		ctxLogger.Info("Returning cached zones", zap.Reflect("RegionName", regionName))
+		return zones, nil
+	}
+
+	operation := &client.Operation{
+		Name:        "ListZones",
+		Method:      "GET",
+		PathPattern: zonesPath,
+	}
+
+	var zones models.ZoneList
+	var apiErr models.Error
+
+	request := rs.client.NewRequest(operation)
+	ctxLogger.Info("Equivalent curl command", zap.Reflect("URL", request.URL()), zap.Reflect("Operation", operation))
+
+	req := request.PathParameter(regionNameParam, regionName)
+	_, err := req.JSONSuccess(&zones).JSONError(&apiErr).Invoke()
+	if err != nil {
+		return nil, err
+	}
+
+	rs.zones[regionName] = &zones
+	return &zones, nil
+}
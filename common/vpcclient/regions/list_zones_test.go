@@ -0,0 +1,127 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package regions_test ...
+package regions_test
+
+import (
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/regions"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/riaas/test"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+func GetTestContextLogger() (*zap.Logger, zap.AtomicLevel) {
+	consoleDebugging := zapcore.Lock(os.Stdout)
+	consoleErrors := zapcore.Lock(os.Stderr)
+	encoderConfig := zap.NewProductionEncoderConfig()
+	encoderConfig.TimeKey = "ts"
+	encoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
+	traceLevel := zap.NewAtomicLevel()
+	traceLevel.SetLevel(zap.InfoLevel)
+	core := zapcore.NewTee(
+		zapcore.NewCore(zapcore.NewJSONEncoder(encoderConfig), consoleDebugging, zap.LevelEnablerFunc(func(lvl zapcore.Level) bool {
+			return (lvl >= traceLevel.Level()) && (lvl < zapcore.ErrorLevel)
+		})),
+		zapcore.NewCore(zapcore.NewJSONEncoder(encoderConfig), consoleErrors, zap.LevelEnablerFunc(func(lvl zapcore.Level) bool {
+			return lvl >= zapcore.ErrorLevel
+		})),
+	)
+	logger := zap.New(core, zap.AddCaller())
+	return logger, traceLevel
+}
+
+func TestListZones(t *testing.T) {
+	// Setup new style zap logger
+	logger, _ := GetTestContextLogger()
+	defer logger.Sync()
+
+	testCases := []struct {
+		name string
+
+		// Response
+		status  int
+		content string
+
+		// Expected return
+		expectErr string
+		verify    func(*testing.T, *models.ZoneList)
+	}{
+		{
+			name:   "Verify that the correct endpoint is invoked",
+			status: http.StatusNoContent,
+		}, {
+			name:      "Verify that a 404 is returned to the caller",
+			status:    http.StatusNotFound,
+			content:   "{\"errors\":[{\"message\":\"testerr\"}]}",
+			expectErr: "Trace Code:, testerr Please check ",
+		}, {
+			name:    "Verify that the zone list is parsed",
+			status:  http.StatusOK,
+			content: "{\"zones\":[{\"name\":\"us-south-1\",\"status\":\"available\",\"region\":{\"name\":\"us-south\"}},{\"name\":\"us-south-2\",\"status\":\"available\",\"region\":{\"name\":\"us-south\"}}]}",
+			verify: func(t *testing.T, zones *models.ZoneList) {
+				assert.Equal(t, 2, len(zones.Zones))
+				assert.Equal(t, "us-south-1", zones.Zones[0].Name)
+				assert.Equal(t, "available", zones.Zones[0].Status)
+				assert.Equal(t, "us-south", zones.Zones[1].Region.Name)
+			},
+		},
+	}
+
+	for _, testcase := range testCases {
+		t.Run(testcase.name, func(t *testing.T) {
+			mux, client, teardown := test.SetupServer(t)
+			requestCount := 0
+			test.SetupMuxResponse(t, mux, regions.Version+"/regions/us-south/zones", http.MethodGet, nil, testcase.status, testcase.content, func(t *testing.T, r *http.Request) {
+				requestCount++
+			})
+
+			defer teardown()
+
+			logger.Info("Test case being executed", zap.Reflect("testcase", testcase.name))
+
+			regionService := regions.New(client)
+
+			zones, err := regionService.ListZones("us-south", logger)
+			logger.Info("Zones", zap.Reflect("zones", zones))
+
+			if testcase.expectErr != "" && assert.Error(t, err) {
+				assert.Equal(t, testcase.expectErr, err.Error())
+				assert.Nil(t, zones)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, zones)
+			}
+
+			if testcase.verify != nil {
+				testcase.verify(t, zones)
+			}
+
+			// Successful lookups are served from the cache of the service
+			_, _ = regionService.ListZones("us-south", logger)
+			if err == nil {
+				assert.Equal(t, 1, requestCount)
+			} else {
+				assert.Equal(t, 2, requestCount)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package regions ...
+package regions
+
+import (
+	"sync"
+
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/client"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
+	"go.uber.org/zap"
+)
+
+// RegionManager operations
+//go:generate counterfeiter -o fakes/region_service.go --fake-name RegionService . RegionManager
+type RegionManager interface {
+	// List all the regions
+	ListRegions(ctxLogger *zap.Logger) (*models.RegionList, error)
+
+	// List all the zones of a region
+	ListZones(regionName string, ctxLogger *zap.Logger) (*models.ZoneList, error)
+}
+
+// RegionService caches the regions and zones it fetched, as they hardly ever change
+// the cache is kept for the lifetime of the service
+type RegionService struct {
+	client client.SessionClient
+
+	mutex   sync.Mutex
+	regions *models.RegionList
+	zones   map[string]*models.ZoneList
+}
+
+var _ RegionManager = &RegionService{}
+
+// New ...
+func New(client client.SessionClient) RegionManager {
+	return &RegionService{
+		client: client,
+		zones:  map[string]*models.ZoneList{},
+	}
+}
@@ -0,0 +1,30 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package regions ...
+// Package resourcemanager ...
+package resourcemanager
+
+const (
+	// DefaultURL is the endpoint of the IBM Cloud resource manager
+	DefaultURL = "https://resource-controller.cloud.ibm.com"
+
+	// Version of the resource manager service
+	Version            = "v2"
+	resourceGroupsPath = Version + "/resource_groups"
+
+	accountIDQueryKey = "account_id"
+	nameQueryKey      = "name"
+)
@@ -0,0 +1,73 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package regions ...
+// Package resourcemanager ...
+package resourcemanager
+
+import (
+	"time"
+
+	util "github.com/IBM/ibmcloud-volume-interface/lib/utils"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/client"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
+	"go.uber.org/zap"
+)
+
+// GetResourceGroupByName GETs /resource_groups?account_id={account_id}&name={name}. Resolved resource groups are
+// cached by the resource group cache of the service, a nil resource group is returned if there is no resource group with the name
+func (rs *ResourceGroupService) GetResourceGroupByName(name string, accountID string, ctxLogger *zap.Logger) (*models.ResourceGroup, error) {
+	ctxLogger.Debug("Entry Backend GetResourceGroupByName")
+	defer ctxLogger.Debug("Exit Backend GetResourceGroupByName")
+
+	defer util.TimeTracker("GetResourceGroupByName", time.Now())
+
+	// The cache is not locked during the request, a resource group resolved concurrently is cached twice
+	if resourceGroup, ok := rs.cache.get(name, accountID); ok {
+		ctxLogger.Info("Returning cached resource group", zap.Reflect("ResourceGroup", resourceGroup))
+		return resourceGroup, nil
+	}
+
+	operation := &client.Operation{
+		Name:        "GetResourceGroupByName",
+		Method:      "GET",
+		PathPattern: resourceGroupsPath,
+	}
+
+	var resourceGroups models.ResourceGroupList
+	var apiErr models.Error
+
+	request := rs.client.NewRequest(operation)
+	ctxLogger.Info("Equivalent curl command", zap.Reflect("URL", request.URL()), zap.Reflect("Operation", operation))
+
+	req := request.JSONSuccess(&resourceGroups).JSONError(&apiErr)
+	if len(accountID) > 0 {
+		req.AddQueryValue(accountIDQueryKey, accountID)
+	}
+	req.AddQueryValue(nameQueryKey, name)
+
+	_, err := req.Invoke()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, resourceGroup := range resourceGroups.Resources {
+		if resourceGroup != nil && resourceGroup.Name == name {
+			rs.cache.set(name, accountID, resourceGroup)
+			return resourceGroup, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,170 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package regions ...
+// Package resourcemanager_test ...
+package resourcemanager_test
+
+import (
+	"net/http"
+	"net/url"
+	"os"
+	"testing"
+
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/resourcemanager"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/riaas/test"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+func GetTestContextLogger() (*zap.Logger, zap.AtomicLevel) {
+	consoleDebugging := zapcore.Lock(os.Stdout)
+	consoleErrors := zapcore.Lock(os.Stderr)
+	encoderConfig := zap.NewProductionEncoderConfig()
+	encoderConfig.TimeKey = "ts"
+	encoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
+	traceLevel := zap.NewAtomicLevel()
+	traceLevel.SetLevel(zap.InfoLevel)
+	core := zapcore.NewTee(
+		zapcore.NewCore(zapcore.NewJSONEncoder(encoderConfig), consoleDebugging, zap.LevelEnablerFunc(func(lvl zapcore.Level) bool {
+			return (lvl >= traceLevel.Level()) && (lvl < zapcore.ErrorLevel)
+		})),
+		zapcore.NewCore(zapcore.NewJSONEncoder(encoderConfig), consoleErrors, zap.LevelEnablerFunc(func(lvl zapcore.Level) bool {
+			return lvl >= zapcore.ErrorLevel
+		})),
+	)
+	logger := zap.New(core, zap.AddCaller())
+	return logger, traceLevel
+}
+
+func TestGetResourceGroupByName(t *testing.T) {
+	// Setup new style zap logger
+	logger, _ := GetTestContextLogger()
+	defer logger.Sync()
+
+	testCases := []struct {
+		name string
+
+		// Response
+		status  int
+		content string
+
+		// Expected return
+		expectErr   string
+		expectCache bool
+		verify      func(*testing.T, *models.ResourceGroup)
+	}{
+		{
+			name:    "Verify that the resource group is resolved",
+			status:  http.StatusOK,
+			content: "{\"resources\":[{\"id\":\"rg-id1\",\"name\":\"other-group\"},{\"id\":\"rg-id2\",\"name\":\"test-group\"}]}",
+			verify: func(t *testing.T, resourceGroup *models.ResourceGroup) {
+				if assert.NotNil(t, resourceGroup) {
+					assert.Equal(t, "rg-id2", resourceGroup.ID)
+					assert.Equal(t, "test-group", resourceGroup.Name)
+				}
+			},
+			expectCache: true,
+		}, {
+			name:    "Verify that nil is returned for an unknown resource group",
+			status:  http.StatusOK,
+			content: "{\"resources\":[]}",
+			verify: func(t *testing.T, resourceGroup *models.ResourceGroup) {
+				assert.Nil(t, resourceGroup)
+			},
+		}, {
+			name:      "Verify that a 403 is returned to the caller",
+			status:    http.StatusForbidden,
+			content:   "{\"errors\":[{\"message\":\"testerr\"}]}",
+			expectErr: "Trace Code:, testerr Please check ",
+		},
+	}
+
+	for _, testcase := range testCases {
+		t.Run(testcase.name, func(t *testing.T) {
+			mux, client, teardown := test.SetupServer(t)
+			requestCount := 0
+			test.SetupMuxResponse(t, mux, "/"+resourcemanager.Version+"/resource_groups", http.MethodGet, nil, testcase.status, testcase.content, func(t *testing.T, r *http.Request) {
+				requestCount++
+				query, err := url.ParseQuery(r.URL.RawQuery)
+				if assert.NoError(t, err) {
+					assert.Equal(t, "test-account", query.Get("account_id"))
+					assert.Equal(t, "test-group", query.Get("name"))
+				}
+			})
+
+			defer teardown()
+
+			logger.Info("Test case being executed", zap.Reflect("testcase", testcase.name))
+
+			resourceGroupService := resourcemanager.New(client)
+
+			resourceGroup, err := resourceGroupService.GetResourceGroupByName("test-group", "test-account", logger)
+			logger.Info("Resource group", zap.Reflect("resourceGroup", resourceGroup))
+
+			if testcase.expectErr != "" && assert.Error(t, err) {
+				assert.Equal(t, testcase.expectErr, err.Error())
+				assert.Nil(t, resourceGroup)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if testcase.verify != nil {
+				testcase.verify(t, resourceGroup)
+			}
+
+			// Only resolved resource groups are served from the cache of the service
+			_, _ = resourceGroupService.GetResourceGroupByName("test-group", "test-account", logger)
+			if testcase.expectCache {
+				assert.Equal(t, 1, requestCount)
+			} else {
+				assert.Equal(t, 2, requestCount)
+			}
+		})
+	}
+}
+
+func TestGetResourceGroupByNameWithCache(t *testing.T) {
+	logger, _ := GetTestContextLogger()
+	defer logger.Sync()
+
+	mux, client, teardown := test.SetupServer(t)
+	defer teardown()
+	requestCount := 0
+	test.SetupMuxResponse(t, mux, "/"+resourcemanager.Version+"/resource_groups", http.MethodGet, nil, http.StatusOK, "{\"resources\":[{\"id\":\"rg-id2\",\"name\":\"test-group\"}]}", func(t *testing.T, r *http.Request) {
+		requestCount++
+	})
+
+	// The services of the same cache resolve each resource group once
+	cache := resourcemanager.NewResourceGroupCache()
+	resourceGroup, err := resourcemanager.NewWithCache(client, cache).GetResourceGroupByName("test-group", "test-account", logger)
+	assert.NoError(t, err)
+	if assert.NotNil(t, resourceGroup) {
+		assert.Equal(t, "rg-id2", resourceGroup.ID)
+	}
+	resourceGroup, err = resourcemanager.NewWithCache(client, cache).GetResourceGroupByName("test-group", "test-account", logger)
+	assert.NoError(t, err)
+	if assert.NotNil(t, resourceGroup) {
+		assert.Equal(t, "rg-id2", resourceGroup.ID)
+	}
+	assert.Equal(t, 1, requestCount)
+
+	// The resource groups are cached per account
+	_, err = resourcemanager.NewWithCache(client, cache).GetResourceGroupByName("test-group", "other-account", logger)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, requestCount)
+}
@@ -0,0 +1,87 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package regions ...
+// Package resourcemanager ...
+package resourcemanager
+
+import (
+	"sync"
+
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/client"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
+	"go.uber.org/zap"
+)
+
+// ResourceGroupManager operations
+//go:generate counterfeiter -o fakes/resource_group_service.go --fake-name ResourceGroupService . ResourceGroupManager
+type ResourceGroupManager interface {
+	// Get the resource group of the account by using the resource group name
+	GetResourceGroupByName(name string, accountID string, ctxLogger *zap.Logger) (*models.ResourceGroup, error)
+}
+
+// ResourceGroupService resolves resource groups through the resource group cache, the cache can be shared by
+// several services so that it outlives the sessions
+type ResourceGroupService struct {
+	client client.SessionClient
+	cache  *ResourceGroupCache
+}
+
+var _ ResourceGroupManager = &ResourceGroupService{}
+
+// New ...
+func New(client client.SessionClient) ResourceGroupManager {
+	return NewWithCache(client, nil)
+}
+
+// NewWithCache returns the service for the resource group cache, a cache of its own is used if the cache is nil
+func NewWithCache(client client.SessionClient, cache *ResourceGroupCache) ResourceGroupManager {
+	if cache == nil {
+		cache = NewResourceGroupCache()
+	}
+	return &ResourceGroupService{
+		client: client,
+		cache:  cache,
+	}
+}
+
+// ResourceGroupCache keeps the resource groups resolved by name. As a resource group can not be renamed
+// to the name of another one the resource groups are kept for the lifetime of the cache
+type ResourceGroupCache struct {
+	mutex          sync.RWMutex
+	resourceGroups map[string]*models.ResourceGroup
+}
+
+// NewResourceGroupCache ...
+func NewResourceGroupCache() *ResourceGroupCache {
+	return &ResourceGroupCache{
+		resourceGroups: map[string]*models.ResourceGroup{},
+	}
+}
+
+// get returns the cached resource group of the account with the name
+func (c *ResourceGroupCache) get(name string, accountID string) (*models.ResourceGroup, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	resourceGroup, ok := c.resourceGroups[accountID+"/"+name]
+	return resourceGroup, ok
+}
+
+// set caches the resource group of the account with the name
+func (c *ResourceGroupCache) set(name string, accountID string, resourceGroup *models.ResourceGroup) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.resourceGroups[accountID+"/"+name] = resourceGroup
+}
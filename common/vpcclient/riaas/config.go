@@ -21,6 +21,8 @@ import (
 	"context"
 	"io"
 	"net/http"
+
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/resourcemanager"
 )
 
 // Config for the Session
@@ -38,6 +40,13 @@ type Config struct {
 	Context       context.Context
 	APIVersion    string
 	APIGeneration int
+
+	// ResourceManagerURL is the endpoint used to resolve resource group names, defaults to resourcemanager.DefaultURL
+	ResourceManagerURL string
+
+	// ResourceGroupCache keeps the resolved resource group names for all the sessions created with the config,
+	// each session has a cache of its own if it is nil
+	ResourceGroupCache *resourcemanager.ResourceGroupCache
 }
 
 func (c Config) httpClient() *http.Client {
@@ -51,3 +60,11 @@ func (c Config) httpClient() *http.Client {
 func (c Config) baseURL() string {
 	return c.BaseURL
 }
+
+func (c Config) resourceManagerURL() string {
+	if c.ResourceManagerURL != "" {
+		return c.ResourceManagerURL
+	}
+
+	return resourcemanager.DefaultURL
+}
@@ -21,6 +21,8 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/resourcemanager"
+
 	"github.com/stretchr/testify/assert"
 )
 
@@ -40,4 +42,7 @@ func TestConfig(t *testing.T) {
 	cfg.HTTPClient = &http.Client{}
 	assert.NotNil(t, cfg.httpClient())
 	assert.Equal(t, "http://gc", cfg.baseURL())
+	assert.Equal(t, resourcemanager.DefaultURL, cfg.resourceManagerURL())
+	cfg.ResourceManagerURL = "http://rm"
+	assert.Equal(t, "http://rm", cfg.resourceManagerURL())
 }
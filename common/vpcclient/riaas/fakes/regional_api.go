@@ -2,9 +2,13 @@
 package fakes
 
 import (
+	context "context"
 	sync "sync"
 
+	client "github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/client"
 	instances "github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/instances"
+	regions "github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/regions"
+	resourcemanager "github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/resourcemanager"
 	riaas "github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/riaas"
 	vpcvolume "github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/vpcvolume"
 )
@@ -20,6 +24,15 @@ type RegionalAPI struct {
 	iKSVolumeAttachServiceReturnsOnCall map[int]struct {
 		result1 instances.VolumeAttachManager
 	}
+	InstanceServiceStub        func() instances.InstanceManager
+	instanceServiceMutex       sync.RWMutex
+	instanceServiceArgsForCall []struct{}
+	instanceServiceReturns     struct {
+		result1 instances.InstanceManager
+	}
+	instanceServiceReturnsOnCall map[int]struct {
+		result1 instances.InstanceManager
+	}
 	LoginStub        func(string) error
 	loginMutex       sync.RWMutex
 	loginArgsForCall []struct {
@@ -31,6 +44,44 @@ type RegionalAPI struct {
 	loginReturnsOnCall map[int]struct {
 		result1 error
 	}
+	LoginWithTokenSourceStub        func(client.TokenSource) error
+	loginWithTokenSourceMutex       sync.RWMutex
+	loginWithTokenSourceArgsForCall []struct {
+		arg1 client.TokenSource
+	}
+	loginWithTokenSourceReturns struct {
+		result1 error
+	}
+	loginWithTokenSourceReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ProfileServiceStub        func() vpcvolume.ProfileManager
+	profileServiceMutex       sync.RWMutex
+	profileServiceArgsForCall []struct{}
+	profileServiceReturns     struct {
+		result1 vpcvolume.ProfileManager
+	}
+	profileServiceReturnsOnCall map[int]struct {
+		result1 vpcvolume.ProfileManager
+	}
+	RegionServiceStub        func() regions.RegionManager
+	regionServiceMutex       sync.RWMutex
+	regionServiceArgsForCall []struct{}
+	regionServiceReturns     struct {
+		result1 regions.RegionManager
+	}
+	regionServiceReturnsOnCall map[int]struct {
+		result1 regions.RegionManager
+	}
+	ResourceGroupServiceStub        func() resourcemanager.ResourceGroupManager
+	resourceGroupServiceMutex       sync.RWMutex
+	resourceGroupServiceArgsForCall []struct{}
+	resourceGroupServiceReturns     struct {
+		result1 resourcemanager.ResourceGroupManager
+	}
+	resourceGroupServiceReturnsOnCall map[int]struct {
+		result1 resourcemanager.ResourceGroupManager
+	}
 	SnapshotServiceStub        func() vpcvolume.SnapshotManager
 	snapshotServiceMutex       sync.RWMutex
 	snapshotServiceArgsForCall []struct {
@@ -61,6 +112,17 @@ type RegionalAPI struct {
 	volumeServiceReturnsOnCall map[int]struct {
 		result1 vpcvolume.VolumeManager
 	}
+	WithContextStub        func(context.Context) riaas.RegionalAPI
+	withContextMutex       sync.RWMutex
+	withContextArgsForCall []struct {
+		arg1 context.Context
+	}
+	withContextReturns struct {
+		result1 riaas.RegionalAPI
+	}
+	withContextReturnsOnCall map[int]struct {
+		result1 riaas.RegionalAPI
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -117,6 +179,57 @@ func (fake *RegionalAPI) IKSVolumeAttachServiceReturnsOnCall(i int, result1 inst
 	}{result1}
 }
 
+func (fake *RegionalAPI) InstanceService() instances.InstanceManager {
+	fake.instanceServiceMutex.Lock()
+	ret, specificReturn := fake.instanceServiceReturnsOnCall[len(fake.instanceServiceArgsForCall)]
+	fake.instanceServiceArgsForCall = append(fake.instanceServiceArgsForCall, struct{}{})
+	fake.recordInvocation("InstanceService", []interface{}{})
+	fake.instanceServiceMutex.Unlock()
+	if fake.InstanceServiceStub != nil {
+		return fake.InstanceServiceStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.instanceServiceReturns
+	return fakeReturns.result1
+}
+
+func (fake *RegionalAPI) InstanceServiceCallCount() int {
+	fake.instanceServiceMutex.RLock()
+	defer fake.instanceServiceMutex.RUnlock()
+	return len(fake.instanceServiceArgsForCall)
+}
+
+func (fake *RegionalAPI) InstanceServiceCalls(stub func() instances.InstanceManager) {
+	fake.instanceServiceMutex.Lock()
+	defer fake.instanceServiceMutex.Unlock()
+	fake.InstanceServiceStub = stub
+}
+
+func (fake *RegionalAPI) InstanceServiceReturns(result1 instances.InstanceManager) {
+	fake.instanceServiceMutex.Lock()
+	defer fake.instanceServiceMutex.Unlock()
+	fake.InstanceServiceStub = nil
+	fake.instanceServiceReturns = struct {
+		result1 instances.InstanceManager
+	}{result1}
+}
+
+func (fake *RegionalAPI) InstanceServiceReturnsOnCall(i int, result1 instances.InstanceManager) {
+	fake.instanceServiceMutex.Lock()
+	defer fake.instanceServiceMutex.Unlock()
+	fake.InstanceServiceStub = nil
+	if fake.instanceServiceReturnsOnCall == nil {
+		fake.instanceServiceReturnsOnCall = make(map[int]struct {
+			result1 instances.InstanceManager
+		})
+	}
+	fake.instanceServiceReturnsOnCall[i] = struct {
+		result1 instances.InstanceManager
+	}{result1}
+}
+
 func (fake *RegionalAPI) Login(arg1 string) error {
 	fake.loginMutex.Lock()
 	ret, specificReturn := fake.loginReturnsOnCall[len(fake.loginArgsForCall)]
@@ -177,6 +290,219 @@ func (fake *RegionalAPI) LoginReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *RegionalAPI) LoginWithTokenSource(arg1 client.TokenSource) error {
+	fake.loginWithTokenSourceMutex.Lock()
+	ret, specificReturn := fake.loginWithTokenSourceReturnsOnCall[len(fake.loginWithTokenSourceArgsForCall)]
+	fake.loginWithTokenSourceArgsForCall = append(fake.loginWithTokenSourceArgsForCall, struct {
+		arg1 client.TokenSource
+	}{arg1})
+	fake.recordInvocation("LoginWithTokenSource", []interface{}{arg1})
+	fake.loginWithTokenSourceMutex.Unlock()
+	if fake.LoginWithTokenSourceStub != nil {
+		return fake.LoginWithTokenSourceStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.loginWithTokenSourceReturns
+	return fakeReturns.result1
+}
+
+func (fake *RegionalAPI) LoginWithTokenSourceCallCount() int {
+	fake.loginWithTokenSourceMutex.RLock()
+	defer fake.loginWithTokenSourceMutex.RUnlock()
+	return len(fake.loginWithTokenSourceArgsForCall)
+}
+
+func (fake *RegionalAPI) LoginWithTokenSourceCalls(stub func(client.TokenSource) error) {
+	fake.loginWithTokenSourceMutex.Lock()
+	defer fake.loginWithTokenSourceMutex.Unlock()
+	fake.LoginWithTokenSourceStub = stub
+}
+
+func (fake *RegionalAPI) LoginWithTokenSourceArgsForCall(i int) client.TokenSource {
+	fake.loginWithTokenSourceMutex.RLock()
+	defer fake.loginWithTokenSourceMutex.RUnlock()
+	argsForCall := fake.loginWithTokenSourceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *RegionalAPI) LoginWithTokenSourceReturns(result1 error) {
+	fake.loginWithTokenSourceMutex.Lock()
+	defer fake.loginWithTokenSourceMutex.Unlock()
+	fake.LoginWithTokenSourceStub = nil
+	fake.loginWithTokenSourceReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *RegionalAPI) LoginWithTokenSourceReturnsOnCall(i int, result1 error) {
+	fake.loginWithTokenSourceMutex.Lock()
+	defer fake.loginWithTokenSourceMutex.Unlock()
+	fake.LoginWithTokenSourceStub = nil
+	if fake.loginWithTokenSourceReturnsOnCall == nil {
+		fake.loginWithTokenSourceReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.loginWithTokenSourceReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *RegionalAPI) ProfileService() vpcvolume.ProfileManager {
+	fake.profileServiceMutex.Lock()
+	ret, specificReturn := fake.profileServiceReturnsOnCall[len(fake.profileServiceArgsForCall)]
+	fake.profileServiceArgsForCall = append(fake.profileServiceArgsForCall, struct{}{})
+	fake.recordInvocation("ProfileService", []interface{}{})
+	fake.profileServiceMutex.Unlock()
+	if fake.ProfileServiceStub != nil {
+		return fake.ProfileServiceStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.profileServiceReturns
+	return fakeReturns.result1
+}
+
+func (fake *RegionalAPI) ProfileServiceCallCount() int {
+	fake.profileServiceMutex.RLock()
+	defer fake.profileServiceMutex.RUnlock()
+	return len(fake.profileServiceArgsForCall)
+}
+
+func (fake *RegionalAPI) ProfileServiceCalls(stub func() vpcvolume.ProfileManager) {
+	fake.profileServiceMutex.Lock()
+	defer fake.profileServiceMutex.Unlock()
+	fake.ProfileServiceStub = stub
+}
+
+func (fake *RegionalAPI) ProfileServiceReturns(result1 vpcvolume.ProfileManager) {
+	fake.profileServiceMutex.Lock()
+	defer fake.profileServiceMutex.Unlock()
+	fake.ProfileServiceStub = nil
+	fake.profileServiceReturns = struct {
+		result1 vpcvolume.ProfileManager
+	}{result1}
+}
+
+func (fake *RegionalAPI) ProfileServiceReturnsOnCall(i int, result1 vpcvolume.ProfileManager) {
+	fake.profileServiceMutex.Lock()
+	defer fake.profileServiceMutex.Unlock()
+	fake.ProfileServiceStub = nil
+	if fake.profileServiceReturnsOnCall == nil {
+		fake.profileServiceReturnsOnCall = make(map[int]struct {
+			result1 vpcvolume.ProfileManager
+		})
+	}
+	fake.profileServiceReturnsOnCall[i] = struct {
+		result1 vpcvolume.ProfileManager
+	}{result1}
+}
+
+func (fake *RegionalAPI) RegionService() regions.RegionManager {
+	fake.regionServiceMutex.Lock()
+	ret, specificReturn := fake.regionServiceReturnsOnCall[len(fake.regionServiceArgsForCall)]
+	fake.regionServiceArgsForCall = append(fake.regionServiceArgsForCall, struct{}{})
+	fake.recordInvocation("RegionService", []interface{}{})
+	fake.regionServiceMutex.Unlock()
+	if fake.RegionServiceStub != nil {
+		return fake.RegionServiceStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.regionServiceReturns
+	return fakeReturns.result1
+}
+
+func (fake *RegionalAPI) RegionServiceCallCount() int {
+	fake.regionServiceMutex.RLock()
+	defer fake.regionServiceMutex.RUnlock()
+	return len(fake.regionServiceArgsForCall)
+}
+
+func (fake *RegionalAPI) RegionServiceCalls(stub func() regions.RegionManager) {
+	fake.regionServiceMutex.Lock()
+	defer fake.regionServiceMutex.Unlock()
+	fake.RegionServiceStub = stub
+}
+
+func (fake *RegionalAPI) RegionServiceReturns(result1 regions.RegionManager) {
+	fake.regionServiceMutex.Lock()
+	defer fake.regionServiceMutex.Unlock()
+	fake.RegionServiceStub = nil
+	fake.regionServiceReturns = struct {
+		result1 regions.RegionManager
+	}{result1}
+}
+
+func (fake *RegionalAPI) RegionServiceReturnsOnCall(i int, result1 regions.RegionManager) {
+	fake.regionServiceMutex.Lock()
+	defer fake.regionServiceMutex.Unlock()
+	fake.RegionServiceStub = nil
+	if fake.regionServiceReturnsOnCall == nil {
+		fake.regionServiceReturnsOnCall = make(map[int]struct {
+			result1 regions.RegionManager
+		})
+	}
+	fake.regionServiceReturnsOnCall[i] = struct {
+		result1 regions.RegionManager
+	}{result1}
+}
+
+func (fake *RegionalAPI) ResourceGroupService() resourcemanager.ResourceGroupManager {
+	fake.resourceGroupServiceMutex.Lock()
+	ret, specificReturn := fake.resourceGroupServiceReturnsOnCall[len(fake.resourceGroupServiceArgsForCall)]
+	fake.resourceGroupServiceArgsForCall = append(fake.resourceGroupServiceArgsForCall, struct{}{})
+	fake.recordInvocation("ResourceGroupService", []interface{}{})
+	fake.resourceGroupServiceMutex.Unlock()
+	if fake.ResourceGroupServiceStub != nil {
+		return fake.ResourceGroupServiceStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.resourceGroupServiceReturns
+	return fakeReturns.result1
+}
+
+func (fake *RegionalAPI) ResourceGroupServiceCallCount() int {
+	fake.resourceGroupServiceMutex.RLock()
+	defer fake.resourceGroupServiceMutex.RUnlock()
+	return len(fake.resourceGroupServiceArgsForCall)
+}
+
+func (fake *RegionalAPI) ResourceGroupServiceCalls(stub func() resourcemanager.ResourceGroupManager) {
+	fake.resourceGroupServiceMutex.Lock()
+	defer fake.resourceGroupServiceMutex.Unlock()
+	fake.ResourceGroupServiceStub = stub
+}
+
+func (fake *RegionalAPI) ResourceGroupServiceReturns(result1 resourcemanager.ResourceGroupManager) {
+	fake.resourceGroupServiceMutex.Lock()
+	defer fake.resourceGroupServiceMutex.Unlock()
+	fake.ResourceGroupServiceStub = nil
+	fake.resourceGroupServiceReturns = struct {
+		result1 resourcemanager.ResourceGroupManager
+	}{result1}
+}
+
+func (fake *RegionalAPI) ResourceGroupServiceReturnsOnCall(i int, result1 resourcemanager.ResourceGroupManager) {
+	fake.resourceGroupServiceMutex.Lock()
+	defer fake.resourceGroupServiceMutex.Unlock()
+	fake.ResourceGroupServiceStub = nil
+	if fake.resourceGroupServiceReturnsOnCall == nil {
+		fake.resourceGroupServiceReturnsOnCall = make(map[int]struct {
+			result1 resourcemanager.ResourceGroupManager
+		})
+	}
+	fake.resourceGroupServiceReturnsOnCall[i] = struct {
+		result1 resourcemanager.ResourceGroupManager
+	}{result1}
+}
+
 func (fake *RegionalAPI) SnapshotService() vpcvolume.SnapshotManager {
 	fake.snapshotServiceMutex.Lock()
 	ret, specificReturn := fake.snapshotServiceReturnsOnCall[len(fake.snapshotServiceArgsForCall)]
@@ -333,19 +659,91 @@ func (fake *RegionalAPI) VolumeServiceReturnsOnCall(i int, result1 vpcvolume.Vol
 	}{result1}
 }
 
+func (fake *RegionalAPI) WithContext(arg1 context.Context) riaas.RegionalAPI {
+	fake.withContextMutex.Lock()
+	ret, specificReturn := fake.withContextReturnsOnCall[len(fake.withContextArgsForCall)]
+	fake.withContextArgsForCall = append(fake.withContextArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	fake.recordInvocation("WithContext", []interface{}{arg1})
+	fake.withContextMutex.Unlock()
+	if fake.WithContextStub != nil {
+		return fake.WithContextStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.withContextReturns
+	return fakeReturns.result1
+}
+
+func (fake *RegionalAPI) WithContextCallCount() int {
+	fake.withContextMutex.RLock()
+	defer fake.withContextMutex.RUnlock()
+	return len(fake.withContextArgsForCall)
+}
+
+func (fake *RegionalAPI) WithContextCalls(stub func(context.Context) riaas.RegionalAPI) {
+	fake.withContextMutex.Lock()
+	defer fake.withContextMutex.Unlock()
+	fake.WithContextStub = stub
+}
+
+func (fake *RegionalAPI) WithContextArgsForCall(i int) context.Context {
+	fake.withContextMutex.RLock()
+	defer fake.withContextMutex.RUnlock()
+	argsForCall := fake.withContextArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *RegionalAPI) WithContextReturns(result1 riaas.RegionalAPI) {
+	fake.withContextMutex.Lock()
+	defer fake.withContextMutex.Unlock()
+	fake.WithContextStub = nil
+	fake.withContextReturns = struct {
+		result1 riaas.RegionalAPI
+	}{result1}
+}
+
+func (fake *RegionalAPI) WithContextReturnsOnCall(i int, result1 riaas.RegionalAPI) {
+	fake.withContextMutex.Lock()
+	defer fake.withContextMutex.Unlock()
+	fake.WithContextStub = nil
+	if fake.withContextReturnsOnCall == nil {
+		fake.withContextReturnsOnCall = make(map[int]struct {
+			result1 riaas.RegionalAPI
+		})
+	}
+	fake.withContextReturnsOnCall[i] = struct {
+		result1 riaas.RegionalAPI
+	}{result1}
+}
+
 func (fake *RegionalAPI) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.iKSVolumeAttachServiceMutex.RLock()
 	defer fake.iKSVolumeAttachServiceMutex.RUnlock()
+	fake.instanceServiceMutex.RLock()
+	defer fake.instanceServiceMutex.RUnlock()
 	fake.loginMutex.RLock()
 	defer fake.loginMutex.RUnlock()
+	fake.loginWithTokenSourceMutex.RLock()
+	defer fake.loginWithTokenSourceMutex.RUnlock()
+	fake.profileServiceMutex.RLock()
+	defer fake.profileServiceMutex.RUnlock()
+	fake.regionServiceMutex.RLock()
+	defer fake.regionServiceMutex.RUnlock()
+	fake.resourceGroupServiceMutex.RLock()
+	defer fake.resourceGroupServiceMutex.RUnlock()
 	fake.snapshotServiceMutex.RLock()
 	defer fake.snapshotServiceMutex.RUnlock()
 	fake.volumeAttachServiceMutex.RLock()
 	defer fake.volumeAttachServiceMutex.RUnlock()
 	fake.volumeServiceMutex.RLock()
 	defer fake.volumeServiceMutex.RUnlock()
+	fake.withContextMutex.RLock()
+	defer fake.withContextMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -25,6 +25,8 @@ import (
 	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/client"
 	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/instances"
 	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/regions"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/resourcemanager"
 	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/vpcvolume"
 )
 
@@ -33,11 +35,18 @@ import (
 //go:generate counterfeiter -o fakes/regional_api.go --fake-name RegionalAPI . RegionalAPI
 type RegionalAPI interface {
 	Login(token string) error
+	LoginWithTokenSource(tokenSource client.TokenSource) error
 
 	VolumeService() vpcvolume.VolumeManager
 	VolumeAttachService() instances.VolumeAttachManager
 	IKSVolumeAttachService() instances.VolumeAttachManager
+	InstanceService() instances.InstanceManager
 	SnapshotService() vpcvolume.SnapshotManager
+	ProfileService() vpcvolume.ProfileManager
+	RegionService() regions.RegionManager
+	ResourceGroupService() resourcemanager.ResourceGroupManager
+
+	WithContext(ctx context.Context) RegionalAPI
 }
 
 var _ RegionalAPI = &Session{}
@@ -46,6 +55,18 @@ var _ RegionalAPI = &Session{}
 type Session struct {
 	client client.SessionClient
 	config Config
+
+	// resourceManagerClient is used for the resource manager API, which is not served by the regional endpoint
+	resourceManagerClient client.SessionClient
+
+	// profileService and regionService are shared by the session so that the volume profiles, regions
+	// and zones are fetched only once
+	profileService vpcvolume.ProfileManager
+	regionService  regions.RegionManager
+
+	// resourceGroupCache keeps the resolved resource groups, it is shared with the other sessions of the config if any
+	resourceGroupCache   *resourcemanager.ResourceGroupCache
+	resourceGroupService resourcemanager.ResourceGroupManager
 }
 
 // New creates a new Session volume, using the supplied config
@@ -76,12 +97,25 @@ func New(config Config) (*Session, error) {
 
 	riaasClient := client.New(ctx, config.baseURL(), queryValues, config.httpClient(), config.ContextID, config.ResourceGroup)
 
+	resourceManagerClient := client.New(ctx, config.resourceManagerURL(), url.Values{}, config.httpClient(), config.ContextID, "")
+
 	if config.DebugWriter != nil {
 		riaasClient.WithDebug(config.DebugWriter)
+		resourceManagerClient.WithDebug(config.DebugWriter)
+	}
+
+	resourceGroupCache := config.ResourceGroupCache
+	if resourceGroupCache == nil {
+		resourceGroupCache = resourcemanager.NewResourceGroupCache()
 	}
 	return &Session{
-		client: riaasClient,
-		config: config,
+		client:                riaasClient,
+		config:                config,
+		resourceManagerClient: resourceManagerClient,
+		profileService:        vpcvolume.NewProfileManager(riaasClient),
+		regionService:         regions.New(riaasClient),
+		resourceGroupCache:    resourceGroupCache,
+		resourceGroupService:  resourcemanager.NewWithCache(resourceManagerClient, resourceGroupCache),
 	}, nil
 }
 
@@ -89,6 +123,19 @@ func New(config Config) (*Session, error) {
 // which is used for all requests to the API
 func (s *Session) Login(token string) error {
 	s.client.WithAuthToken(token)
+	if s.resourceManagerClient != nil {
+		s.resourceManagerClient.WithAuthToken(token)
+	}
+	return nil
+}
+
+// LoginWithTokenSource configures the session with the supplied source of the Authentication token,
+// the token is renewed by the source when it is rejected by the API
+func (s *Session) LoginWithTokenSource(tokenSource client.TokenSource) error {
+	s.client.WithTokenSource(tokenSource)
+	if s.resourceManagerClient != nil {
+		s.resourceManagerClient.WithTokenSource(tokenSource)
+	}
 	return nil
 }
 
@@ -107,11 +154,59 @@ func (s *Session) IKSVolumeAttachService() instances.VolumeAttachManager {
 	return instances.NewIKSVolumeAttachmentManager(s.client)
 }
 
+// InstanceService returns the Instance service for looking up instances
+func (s *Session) InstanceService() instances.InstanceManager {
+	return instances.NewInstanceManager(s.client)
+}
+
 // SnapshotService returns the Snapshot service for managing snapshot
 func (s *Session) SnapshotService() vpcvolume.SnapshotManager {
 	return vpcvolume.NewSnapshotManager(s.client)
 }
 
+// ProfileService returns the Profile service for looking up volume profiles, the profiles are cached per session
+func (s *Session) ProfileService() vpcvolume.ProfileManager {
+	if s.profileService == nil {
+		return vpcvolume.NewProfileManager(s.client)
+	}
+	return s.profileService
+}
+
+// RegionService returns the Region service for listing regions and zones, they are cached per session
+func (s *Session) RegionService() regions.RegionManager {
+	if s.regionService == nil {
+		return regions.New(s.client)
+	}
+	return s.regionService
+}
+
+// ResourceGroupService returns the ResourceGroup service for resolving resource group names, they are cached in
+// the resource group cache of the config
+func (s *Session) ResourceGroupService() resourcemanager.ResourceGroupManager {
+	if s.resourceGroupService == nil {
+		return resourcemanager.NewWithCache(s.resourceManagerClient, s.resourceGroupCache)
+	}
+	return s.resourceGroupService
+}
+
+// WithContext returns a copy of the session whose requests are sent with the supplied context, so that each
+// operation is bound to its own context. The cached profiles, regions and resource groups are shared with the session,
+// the profiles and regions are fetched with the context of the config
+func (s *Session) WithContext(ctx context.Context) RegionalAPI {
+	return s.withContext(ctx)
+}
+
+// withContext returns a copy of the session with its clients bound to ctx
+func (s *Session) withContext(ctx context.Context) *Session {
+	contextSession := *s
+	contextSession.client = s.client.WithContext(ctx)
+	if s.resourceManagerClient != nil {
+		contextSession.resourceManagerClient = s.resourceManagerClient.WithContext(ctx)
+		contextSession.resourceGroupService = resourcemanager.NewWithCache(contextSession.resourceManagerClient, s.resourceGroupCache)
+	}
+	return &contextSession
+}
+
 // RegionalAPIClientProvider declares an interface for a provider that can supply a new
 // RegionalAPI client session
 //go:generate counterfeiter -o fakes/client_provider.go --fake-name RegionalAPIClientProvider . RegionalAPIClientProvider
@@ -143,7 +238,14 @@ func (s *IKSSession) VolumeService() vpcvolume.VolumeManager {
 	return vpcvolume.NewIKSVolumeService(s.client)
 }
 
-//IKSRegionalAPIClientProvider ...
+// WithContext returns a copy of the session whose requests are sent with the supplied context
+func (s *IKSSession) WithContext(ctx context.Context) RegionalAPI {
+	return &IKSSession{
+		Session: *s.Session.withContext(ctx),
+	}
+}
+
+// IKSRegionalAPIClientProvider ...
 type IKSRegionalAPIClientProvider struct {
 	RegionalAPIClientProvider
 }
@@ -19,11 +19,13 @@ package riaas
 
 import (
 	"bytes"
+	"context"
 	"io"
 	"net/http"
 	"testing"
 
 	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/client/fakes"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/resourcemanager"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -41,6 +43,35 @@ func TestLogin(t *testing.T) {
 	}
 
 	assert.NoError(t, err)
+
+	// The token is used for the resource manager as well
+	resourceManagerClient := &fakes.SessionClient{}
+	riaas.resourceManagerClient = resourceManagerClient
+	err = riaas.Login("token")
+	if assert.Equal(t, 1, resourceManagerClient.WithAuthTokenCallCount()) {
+		assert.Equal(t, "token", resourceManagerClient.WithAuthTokenArgsForCall(0))
+	}
+	assert.NoError(t, err)
+}
+
+func TestLoginWithTokenSource(t *testing.T) {
+	client := &fakes.SessionClient{}
+	resourceManagerClient := &fakes.SessionClient{}
+	tokenSource := &fakes.TokenSource{}
+
+	riaas := Session{
+		client:                client,
+		resourceManagerClient: resourceManagerClient,
+	}
+
+	err := riaas.LoginWithTokenSource(tokenSource)
+	assert.NoError(t, err)
+	if assert.Equal(t, 1, client.WithTokenSourceCallCount()) {
+		assert.Equal(t, tokenSource, client.WithTokenSourceArgsForCall(0))
+	}
+	if assert.Equal(t, 1, resourceManagerClient.WithTokenSourceCallCount()) {
+		assert.Equal(t, tokenSource, resourceManagerClient.WithTokenSourceArgsForCall(0))
+	}
 }
 
 func TestNewSession(t *testing.T) {
@@ -94,7 +125,83 @@ func TestSnapshotService(t *testing.T) {
 	assert.NotNil(t, snapshotManager)
 }
 
+func TestProfileService(t *testing.T) {
+	profileManager := (&Session{}).ProfileService()
+	assert.NotNil(t, profileManager)
+
+	sessionAPI, err := New(Config{BaseURL: "http://gc", AccountID: "test account ID"})
+	assert.Nil(t, err)
+	if assert.NotNil(t, sessionAPI) {
+		// The same service, and so the same profile cache, is used for the whole session
+		assert.True(t, sessionAPI.ProfileService() == sessionAPI.ProfileService())
+	}
+}
+
+func TestRegionService(t *testing.T) {
+	regionManager := (&Session{}).RegionService()
+	assert.NotNil(t, regionManager)
+
+	sessionAPI, err := New(Config{BaseURL: "http://gc", AccountID: "test account ID"})
+	assert.Nil(t, err)
+	if assert.NotNil(t, sessionAPI) {
+		// The same service, and so the same zone cache, is used for the whole session
+		assert.True(t, sessionAPI.RegionService() == sessionAPI.RegionService())
+	}
+}
+
 func TestVolumeAttachService(t *testing.T) {
 	volumeAttachService := (&Session{}).VolumeAttachService()
 	assert.NotNil(t, volumeAttachService)
 }
+
+func TestInstanceService(t *testing.T) {
+	instanceService := (&Session{}).InstanceService()
+	assert.NotNil(t, instanceService)
+}
+
+func TestResourceGroupService(t *testing.T) {
+	resourceGroupManager := (&Session{}).ResourceGroupService()
+	assert.NotNil(t, resourceGroupManager)
+
+	sessionAPI, err := New(Config{BaseURL: "http://gc", AccountID: "test account ID"})
+	assert.Nil(t, err)
+	if assert.NotNil(t, sessionAPI) {
+		// The same service, and so the same resource group cache, is used for the whole session
+		assert.True(t, sessionAPI.ResourceGroupService() == sessionAPI.ResourceGroupService())
+	}
+
+	// The resource group cache of the config is shared by its sessions
+	config := Config{BaseURL: "http://gc", AccountID: "test account ID", ResourceGroupCache: resourcemanager.NewResourceGroupCache()}
+	sessionAPI, err = New(config)
+	assert.Nil(t, err)
+	otherSessionAPI, err := New(config)
+	assert.Nil(t, err)
+	if assert.NotNil(t, sessionAPI) && assert.NotNil(t, otherSessionAPI) {
+		assert.True(t, config.ResourceGroupCache == sessionAPI.resourceGroupCache)
+		assert.True(t, config.ResourceGroupCache == otherSessionAPI.resourceGroupCache)
+	}
+}
+
+func TestWithContext(t *testing.T) {
+	sessionAPI, err := New(Config{BaseURL: "http://gc", AccountID: "test account ID"})
+	assert.Nil(t, err)
+	if assert.NotNil(t, sessionAPI) {
+		contextAPI := sessionAPI.WithContext(context.Background())
+		assert.NotNil(t, contextAPI)
+		assert.False(t, contextAPI == RegionalAPI(sessionAPI))
+		// The caches are shared with the session
+		assert.True(t, sessionAPI.ProfileService() == contextAPI.ProfileService())
+		assert.True(t, sessionAPI.RegionService() == contextAPI.RegionService())
+		assert.True(t, sessionAPI.resourceGroupCache == contextAPI.(*Session).resourceGroupCache)
+		// The resource groups are resolved with the context
+		assert.False(t, sessionAPI.ResourceGroupService() == contextAPI.ResourceGroupService())
+	}
+
+	iksSessionAPI, err := IKSRegionalAPIClientProvider{}.New(Config{BaseURL: "http://gc", AccountID: "test account ID"})
+	assert.Nil(t, err)
+	if assert.NotNil(t, iksSessionAPI) {
+		// The IKS services are kept
+		_, ok := iksSessionAPI.WithContext(context.Background()).(*IKSSession)
+		assert.True(t, ok)
+	}
+}
@@ -66,7 +66,8 @@ func TestCheckVolumeTag(t *testing.T) {
 			url:       "/v1/volumes/wrong-volume-id/tags/tag-name",
 			status:    http.StatusNotFound,
 			content:   "{\"id\":\"wrong-vol\",\"name\":\"wrong-vol\",\"capacity\":10,\"iops\":3000,\"status\":\"pending\",\"zone\":{\"name\":\"test-1\",\"href\":\"https://us-south.iaas.cloud.ibm.com/v1/regions/us-south/zones/test-1\"},\"crn\":\"crn:v1:bluemix:public:is:test-1:a/rg1::volume:wrong-vol\", \"tags\":[\"Wrong Tag\"]}",
-			expectErr: "json: cannot unmarshal number into Go value of type models.Error",
+			// The body is not an error, the status of the response is returned
+			expectErr: "Unknown error, HTTP status code 404",
 		}, {
 			name:    "False positive: What if the tag name is not matched",
 			url:     "/v1/volumes/volume-id/tags/tag-name",
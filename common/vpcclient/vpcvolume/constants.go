@@ -28,6 +28,9 @@ const (
 	snapshotIDParam = "snapshot-id"
 	snapshotIDPath  = snapshotsPath + "/{" + snapshotIDParam + "}"
 
+	snapshotsRootPath  = Version + "/snapshots"
+	snapshotIDRootPath = snapshotsRootPath + "/{" + snapshotIDParam + "}"
+
 	volumeTagsPath    = volumesPath + "/{" + volumeIDParam + "}/" + "tags"
 	volumeTagParam    = "tag-name"
 	volumeTagNamePath = volumeTagsPath + "/{" + volumeTagParam + "}"
@@ -36,4 +39,8 @@ const (
 	snapshotTagParam    = "tag-name"
 	snapshotTagNamePath = snapshotTagsPath + "/{" + snapshotTagParam + "}"
 	updateVolume        = "updateVolume"
+
+	volumeProfilesPath     = Version + "/volume/profiles"
+	volumeProfileNameParam = "profile-name"
+	volumeProfileNamePath  = volumeProfilesPath + "/{" + volumeProfileNameParam + "}"
 )
@@ -52,6 +52,18 @@ type SnapshotService struct {
 	deleteSnapshotReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DeleteSnapshotByIDStub        func(string, *zap.Logger) error
+	deleteSnapshotByIDMutex       sync.RWMutex
+	deleteSnapshotByIDArgsForCall []struct {
+		arg1 string
+		arg2 *zap.Logger
+	}
+	deleteSnapshotByIDReturns struct {
+		result1 error
+	}
+	deleteSnapshotByIDReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DeleteSnapshotTagStub        func(string, string, string, *zap.Logger) error
 	deleteSnapshotTagMutex       sync.RWMutex
 	deleteSnapshotTagArgsForCall []struct {
@@ -81,6 +93,36 @@ type SnapshotService struct {
 		result1 *models.Snapshot
 		result2 error
 	}
+	GetSnapshotByIDStub        func(string, *zap.Logger) (*models.Snapshot, error)
+	getSnapshotByIDMutex       sync.RWMutex
+	getSnapshotByIDArgsForCall []struct {
+		arg1 string
+		arg2 *zap.Logger
+	}
+	getSnapshotByIDReturns struct {
+		result1 *models.Snapshot
+		result2 error
+	}
+	getSnapshotByIDReturnsOnCall map[int]struct {
+		result1 *models.Snapshot
+		result2 error
+	}
+	ListAllSnapshotsStub        func(int, string, *models.ListSnapshotFilters, *zap.Logger) (*models.SnapshotList, error)
+	listAllSnapshotsMutex       sync.RWMutex
+	listAllSnapshotsArgsForCall []struct {
+		arg1 int
+		arg2 string
+		arg3 *models.ListSnapshotFilters
+		arg4 *zap.Logger
+	}
+	listAllSnapshotsReturns struct {
+		result1 *models.SnapshotList
+		result2 error
+	}
+	listAllSnapshotsReturnsOnCall map[int]struct {
+		result1 *models.SnapshotList
+		result2 error
+	}
 	ListSnapshotTagsStub        func(string, string, *zap.Logger) (*[]string, error)
 	listSnapshotTagsMutex       sync.RWMutex
 	listSnapshotTagsArgsForCall []struct {
@@ -318,6 +360,67 @@ func (fake *SnapshotService) DeleteSnapshotReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *SnapshotService) DeleteSnapshotByID(arg1 string, arg2 *zap.Logger) error {
+	fake.deleteSnapshotByIDMutex.Lock()
+	ret, specificReturn := fake.deleteSnapshotByIDReturnsOnCall[len(fake.deleteSnapshotByIDArgsForCall)]
+	fake.deleteSnapshotByIDArgsForCall = append(fake.deleteSnapshotByIDArgsForCall, struct {
+		arg1 string
+		arg2 *zap.Logger
+	}{arg1, arg2})
+	fake.recordInvocation("DeleteSnapshotByID", []interface{}{arg1, arg2})
+	fake.deleteSnapshotByIDMutex.Unlock()
+	if fake.DeleteSnapshotByIDStub != nil {
+		return fake.DeleteSnapshotByIDStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.deleteSnapshotByIDReturns
+	return fakeReturns.result1
+}
+
+func (fake *SnapshotService) DeleteSnapshotByIDCallCount() int {
+	fake.deleteSnapshotByIDMutex.RLock()
+	defer fake.deleteSnapshotByIDMutex.RUnlock()
+	return len(fake.deleteSnapshotByIDArgsForCall)
+}
+
+func (fake *SnapshotService) DeleteSnapshotByIDCalls(stub func(string, *zap.Logger) error) {
+	fake.deleteSnapshotByIDMutex.Lock()
+	defer fake.deleteSnapshotByIDMutex.Unlock()
+	fake.DeleteSnapshotByIDStub = stub
+}
+
+func (fake *SnapshotService) DeleteSnapshotByIDArgsForCall(i int) (string, *zap.Logger) {
+	fake.deleteSnapshotByIDMutex.RLock()
+	defer fake.deleteSnapshotByIDMutex.RUnlock()
+	argsForCall := fake.deleteSnapshotByIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *SnapshotService) DeleteSnapshotByIDReturns(result1 error) {
+	fake.deleteSnapshotByIDMutex.Lock()
+	defer fake.deleteSnapshotByIDMutex.Unlock()
+	fake.DeleteSnapshotByIDStub = nil
+	fake.deleteSnapshotByIDReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *SnapshotService) DeleteSnapshotByIDReturnsOnCall(i int, result1 error) {
+	fake.deleteSnapshotByIDMutex.Lock()
+	defer fake.deleteSnapshotByIDMutex.Unlock()
+	fake.DeleteSnapshotByIDStub = nil
+	if fake.deleteSnapshotByIDReturnsOnCall == nil {
+		fake.deleteSnapshotByIDReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteSnapshotByIDReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *SnapshotService) DeleteSnapshotTag(arg1 string, arg2 string, arg3 string, arg4 *zap.Logger) error {
 	fake.deleteSnapshotTagMutex.Lock()
 	ret, specificReturn := fake.deleteSnapshotTagReturnsOnCall[len(fake.deleteSnapshotTagArgsForCall)]
@@ -446,6 +549,136 @@ func (fake *SnapshotService) GetSnapshotReturnsOnCall(i int, result1 *models.Sna
 	}{result1, result2}
 }
 
+func (fake *SnapshotService) GetSnapshotByID(arg1 string, arg2 *zap.Logger) (*models.Snapshot, error) {
+	fake.getSnapshotByIDMutex.Lock()
+	ret, specificReturn := fake.getSnapshotByIDReturnsOnCall[len(fake.getSnapshotByIDArgsForCall)]
+	fake.getSnapshotByIDArgsForCall = append(fake.getSnapshotByIDArgsForCall, struct {
+		arg1 string
+		arg2 *zap.Logger
+	}{arg1, arg2})
+	fake.recordInvocation("GetSnapshotByID", []interface{}{arg1, arg2})
+	fake.getSnapshotByIDMutex.Unlock()
+	if fake.GetSnapshotByIDStub != nil {
+		return fake.GetSnapshotByIDStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getSnapshotByIDReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *SnapshotService) GetSnapshotByIDCallCount() int {
+	fake.getSnapshotByIDMutex.RLock()
+	defer fake.getSnapshotByIDMutex.RUnlock()
+	return len(fake.getSnapshotByIDArgsForCall)
+}
+
+func (fake *SnapshotService) GetSnapshotByIDCalls(stub func(string, *zap.Logger) (*models.Snapshot, error)) {
+	fake.getSnapshotByIDMutex.Lock()
+	defer fake.getSnapshotByIDMutex.Unlock()
+	fake.GetSnapshotByIDStub = stub
+}
+
+func (fake *SnapshotService) GetSnapshotByIDArgsForCall(i int) (string, *zap.Logger) {
+	fake.getSnapshotByIDMutex.RLock()
+	defer fake.getSnapshotByIDMutex.RUnlock()
+	argsForCall := fake.getSnapshotByIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *SnapshotService) GetSnapshotByIDReturns(result1 *models.Snapshot, result2 error) {
+	fake.getSnapshotByIDMutex.Lock()
+	defer fake.getSnapshotByIDMutex.Unlock()
+	fake.GetSnapshotByIDStub = nil
+	fake.getSnapshotByIDReturns = struct {
+		result1 *models.Snapshot
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SnapshotService) GetSnapshotByIDReturnsOnCall(i int, result1 *models.Snapshot, result2 error) {
+	fake.getSnapshotByIDMutex.Lock()
+	defer fake.getSnapshotByIDMutex.Unlock()
+	fake.GetSnapshotByIDStub = nil
+	if fake.getSnapshotByIDReturnsOnCall == nil {
+		fake.getSnapshotByIDReturnsOnCall = make(map[int]struct {
+			result1 *models.Snapshot
+			result2 error
+		})
+	}
+	fake.getSnapshotByIDReturnsOnCall[i] = struct {
+		result1 *models.Snapshot
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SnapshotService) ListAllSnapshots(arg1 int, arg2 string, arg3 *models.ListSnapshotFilters, arg4 *zap.Logger) (*models.SnapshotList, error) {
+	fake.listAllSnapshotsMutex.Lock()
+	ret, specificReturn := fake.listAllSnapshotsReturnsOnCall[len(fake.listAllSnapshotsArgsForCall)]
+	fake.listAllSnapshotsArgsForCall = append(fake.listAllSnapshotsArgsForCall, struct {
+		arg1 int
+		arg2 string
+		arg3 *models.ListSnapshotFilters
+		arg4 *zap.Logger
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("ListAllSnapshots", []interface{}{arg1, arg2, arg3, arg4})
+	fake.listAllSnapshotsMutex.Unlock()
+	if fake.ListAllSnapshotsStub != nil {
+		return fake.ListAllSnapshotsStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.listAllSnapshotsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *SnapshotService) ListAllSnapshotsCallCount() int {
+	fake.listAllSnapshotsMutex.RLock()
+	defer fake.listAllSnapshotsMutex.RUnlock()
+	return len(fake.listAllSnapshotsArgsForCall)
+}
+
+func (fake *SnapshotService) ListAllSnapshotsCalls(stub func(int, string, *models.ListSnapshotFilters, *zap.Logger) (*models.SnapshotList, error)) {
+	fake.listAllSnapshotsMutex.Lock()
+	defer fake.listAllSnapshotsMutex.Unlock()
+	fake.ListAllSnapshotsStub = stub
+}
+
+func (fake *SnapshotService) ListAllSnapshotsArgsForCall(i int) (int, string, *models.ListSnapshotFilters, *zap.Logger) {
+	fake.listAllSnapshotsMutex.RLock()
+	defer fake.listAllSnapshotsMutex.RUnlock()
+	argsForCall := fake.listAllSnapshotsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *SnapshotService) ListAllSnapshotsReturns(result1 *models.SnapshotList, result2 error) {
+	fake.listAllSnapshotsMutex.Lock()
+	defer fake.listAllSnapshotsMutex.Unlock()
+	fake.ListAllSnapshotsStub = nil
+	fake.listAllSnapshotsReturns = struct {
+		result1 *models.SnapshotList
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *SnapshotService) ListAllSnapshotsReturnsOnCall(i int, result1 *models.SnapshotList, result2 error) {
+	fake.listAllSnapshotsMutex.Lock()
+	defer fake.listAllSnapshotsMutex.Unlock()
+	fake.ListAllSnapshotsStub = nil
+	if fake.listAllSnapshotsReturnsOnCall == nil {
+		fake.listAllSnapshotsReturnsOnCall = make(map[int]struct {
+			result1 *models.SnapshotList
+			result2 error
+		})
+	}
+	fake.listAllSnapshotsReturnsOnCall[i] = struct {
+		result1 *models.SnapshotList
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *SnapshotService) ListSnapshotTags(arg1 string, arg2 string, arg3 *zap.Logger) (*[]string, error) {
 	fake.listSnapshotTagsMutex.Lock()
 	ret, specificReturn := fake.listSnapshotTagsReturnsOnCall[len(fake.listSnapshotTagsArgsForCall)]
@@ -647,10 +880,16 @@ func (fake *SnapshotService) Invocations() map[string][][]interface{} {
 	defer fake.createSnapshotMutex.RUnlock()
 	fake.deleteSnapshotMutex.RLock()
 	defer fake.deleteSnapshotMutex.RUnlock()
+	fake.deleteSnapshotByIDMutex.RLock()
+	defer fake.deleteSnapshotByIDMutex.RUnlock()
 	fake.deleteSnapshotTagMutex.RLock()
 	defer fake.deleteSnapshotTagMutex.RUnlock()
 	fake.getSnapshotMutex.RLock()
 	defer fake.getSnapshotMutex.RUnlock()
+	fake.getSnapshotByIDMutex.RLock()
+	defer fake.getSnapshotByIDMutex.RUnlock()
+	fake.listAllSnapshotsMutex.RLock()
+	defer fake.listAllSnapshotsMutex.RUnlock()
 	fake.listSnapshotTagsMutex.RLock()
 	defer fake.listSnapshotTagsMutex.RUnlock()
 	fake.listSnapshotsMutex.RLock()
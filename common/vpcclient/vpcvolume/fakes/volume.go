@@ -120,6 +120,21 @@ type VolumeService struct {
 		result1 *models.VolumeList
 		result2 error
 	}
+	PatchVolumeStub        func(string, *models.VolumePatch, *zap.Logger) (*models.Volume, error)
+	patchVolumeMutex       sync.RWMutex
+	patchVolumeArgsForCall []struct {
+		arg1 string
+		arg2 *models.VolumePatch
+		arg3 *zap.Logger
+	}
+	patchVolumeReturns struct {
+		result1 *models.Volume
+		result2 error
+	}
+	patchVolumeReturnsOnCall map[int]struct {
+		result1 *models.Volume
+		result2 error
+	}
 	SetVolumeTagStub        func(string, string, *zap.Logger) error
 	setVolumeTagMutex       sync.RWMutex
 	setVolumeTagArgsForCall []struct {
@@ -656,6 +671,71 @@ func (fake *VolumeService) ListVolumesReturnsOnCall(i int, result1 *models.Volum
 	}{result1, result2}
 }
 
+func (fake *VolumeService) PatchVolume(arg1 string, arg2 *models.VolumePatch, arg3 *zap.Logger) (*models.Volume, error) {
+	fake.patchVolumeMutex.Lock()
+	ret, specificReturn := fake.patchVolumeReturnsOnCall[len(fake.patchVolumeArgsForCall)]
+	fake.patchVolumeArgsForCall = append(fake.patchVolumeArgsForCall, struct {
+		arg1 string
+		arg2 *models.VolumePatch
+		arg3 *zap.Logger
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("PatchVolume", []interface{}{arg1, arg2, arg3})
+	fake.patchVolumeMutex.Unlock()
+	if fake.PatchVolumeStub != nil {
+		return fake.PatchVolumeStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.patchVolumeReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *VolumeService) PatchVolumeCallCount() int {
+	fake.patchVolumeMutex.RLock()
+	defer fake.patchVolumeMutex.RUnlock()
+	return len(fake.patchVolumeArgsForCall)
+}
+
+func (fake *VolumeService) PatchVolumeCalls(stub func(string, *models.VolumePatch, *zap.Logger) (*models.Volume, error)) {
+	fake.patchVolumeMutex.Lock()
+	defer fake.patchVolumeMutex.Unlock()
+	fake.PatchVolumeStub = stub
+}
+
+func (fake *VolumeService) PatchVolumeArgsForCall(i int) (string, *models.VolumePatch, *zap.Logger) {
+	fake.patchVolumeMutex.RLock()
+	defer fake.patchVolumeMutex.RUnlock()
+	argsForCall := fake.patchVolumeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *VolumeService) PatchVolumeReturns(result1 *models.Volume, result2 error) {
+	fake.patchVolumeMutex.Lock()
+	defer fake.patchVolumeMutex.Unlock()
+	fake.PatchVolumeStub = nil
+	fake.patchVolumeReturns = struct {
+		result1 *models.Volume
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *VolumeService) PatchVolumeReturnsOnCall(i int, result1 *models.Volume, result2 error) {
+	fake.patchVolumeMutex.Lock()
+	defer fake.patchVolumeMutex.Unlock()
+	fake.PatchVolumeStub = nil
+	if fake.patchVolumeReturnsOnCall == nil {
+		fake.patchVolumeReturnsOnCall = make(map[int]struct {
+			result1 *models.Volume
+			result2 error
+		})
+	}
+	fake.patchVolumeReturnsOnCall[i] = struct {
+		result1 *models.Volume
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *VolumeService) SetVolumeTag(arg1 string, arg2 string, arg3 *zap.Logger) error {
 	fake.setVolumeTagMutex.Lock()
 	ret, specificReturn := fake.setVolumeTagReturnsOnCall[len(fake.setVolumeTagArgsForCall)]
@@ -798,6 +878,8 @@ func (fake *VolumeService) Invocations() map[string][][]interface{} {
 	defer fake.listVolumeTagsMutex.RUnlock()
 	fake.listVolumesMutex.RLock()
 	defer fake.listVolumesMutex.RUnlock()
+	fake.patchVolumeMutex.RLock()
+	defer fake.patchVolumeMutex.RUnlock()
 	fake.setVolumeTagMutex.RLock()
 	defer fake.setVolumeTagMutex.RUnlock()
 	fake.updateVolumeMutex.RLock()
@@ -0,0 +1,93 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package vpcvolume_test ...
+package vpcvolume_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/riaas/test"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/vpcvolume"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestGetSnapshotByID(t *testing.T) {
+	// Setup new style zap logger
+	logger, _ := GetTestContextLogger()
+	defer logger.Sync()
+
+	testCases := []struct {
+		name string
+
+		// Response
+		status  int
+		content string
+
+		// Expected return
+		expectErr string
+		verify    func(*testing.T, *models.Snapshot, error)
+	}{
+		{
+			name:   "Verify that the correct endpoint is invoked",
+			status: http.StatusNoContent,
+		}, {
+			name:      "Verify that a 404 is returned to the caller",
+			status:    http.StatusNotFound,
+			content:   "{\"errors\":[{\"message\":\"testerr\"}]}",
+			expectErr: "Trace Code:, testerr Please check ",
+			verify: func(t *testing.T, snapshot *models.Snapshot, err error) {
+				assert.Nil(t, snapshot)
+				assert.NotNil(t, err)
+			},
+		}, {
+			name:    "Verify that the snapshot is parsed correctly",
+			status:  http.StatusOK,
+			content: "{\"id\":\"snapshot1\",\"name\":\"snapshot1\",\"status\":\"stable\",\"size\":10,\"source_volume\":{\"id\":\"volume1\"}}",
+			verify: func(t *testing.T, snapshot *models.Snapshot, err error) {
+				assert.Nil(t, err)
+				assert.NotNil(t, snapshot)
+				assert.Equal(t, "snapshot1", snapshot.ID)
+				assert.Equal(t, int64(10), snapshot.Size)
+				assert.NotNil(t, snapshot.SourceVolume)
+				assert.Equal(t, "volume1", snapshot.SourceVolume.ID)
+			},
+		},
+	}
+
+	for _, testcase := range testCases {
+		t.Run(testcase.name, func(t *testing.T) {
+			mux, client, teardown := test.SetupServer(t)
+			emptyString := ""
+			test.SetupMuxResponse(t, mux, vpcvolume.Version+"/snapshots/snapshot1", http.MethodGet, &emptyString, testcase.status, testcase.content, nil)
+
+			defer teardown()
+
+			logger.Info("Test case being executed", zap.Reflect("testcase", testcase.name))
+
+			snapshotService := vpcvolume.NewSnapshotManager(client)
+			snapshot, err := snapshotService.GetSnapshotByID("snapshot1", logger)
+			logger.Info("Snapshot details", zap.Reflect("snapshot", snapshot))
+
+			if testcase.verify != nil {
+				testcase.verify(t, snapshot, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package vpcvolume ...
+package vpcvolume
+
+import (
+	"time"
+
+	util "github.com/IBM/ibmcloud-volume-interface/lib/utils"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/client"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
+	"go.uber.org/zap"
+)
+
+// GetVolumeProfile GETs /volume/profiles/{profile-name}. The result is cached by the service
+func (ps *ProfileService) GetVolumeProfile(profileName string, ctxLogger *zap.Logger) (*models.VolumeProfile, error) {
+	ctxLogger.Debug("Entry Backend GetVolumeProfile")
+	defer ctxLogger.Debug("Exit Backend GetVolumeProfile")
+
+	defer util.TimeTracker("GetVolumeProfile", time.Now())
+
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+
+	if profile, ok := ps.profiles[profileName]; ok {
+		ctxLogger.Info("Returning cached volume profile", zap.Reflect("ProfileName", profileName))
+		return profile, nil
+	}
+
+	operation := &client.Operation{
+		Name:        "GetVolumeProfile",
+		Method:      "GET",
+		PathPattern: volumeProfileNamePath,
+	}
+
+	var profile models.VolumeProfile
+	var apiErr models.Error
+
+	request := ps.client.NewRequest(operation)
+	ctxLogger.Info("Equivalent curl command", zap.Reflect("URL", request.URL()), zap.Reflect("Operation", operation))
+
+	req := request.PathParameter(volumeProfileNameParam, profileName)
+	_, err := req.JSONSuccess(&profile).JSONError(&apiErr).Invoke()
+	if err != nil {
+		return nil, err
+	}
+
+	ps.profiles[profileName] = &profile
+	return &profile, nil
+}
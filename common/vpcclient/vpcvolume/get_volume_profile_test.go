@@ -0,0 +1,106 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package vpcvolume_test ...
+package vpcvolume_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/riaas/test"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/vpcvolume"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestGetVolumeProfile(t *testing.T) {
+	// Setup new style zap logger
+	logger, _ := GetTestContextLogger()
+	defer logger.Sync()
+
+	testCases := []struct {
+		name string
+
+		// Response
+		status  int
+		content string
+
+		// Expected return
+		expectErr string
+		verify    func(*testing.T, *models.VolumeProfile, error)
+	}{
+		{
+			name:   "Verify that the correct endpoint is invoked",
+			status: http.StatusNoContent,
+		}, {
+			name:      "Verify that a 404 is returned to the caller",
+			status:    http.StatusNotFound,
+			content:   "{\"errors\":[{\"code\":\"not_found\",\"message\":\"testerr\"}]}",
+			expectErr: "Trace Code:, testerr Please check ",
+			verify: func(t *testing.T, profile *models.VolumeProfile, err error) {
+				assert.Nil(t, profile)
+				assert.NotNil(t, err)
+			},
+		}, {
+			name:    "Verify that the profile is parsed correctly",
+			status:  http.StatusOK,
+			content: "{\"name\":\"custom\",\"family\":\"custom\",\"capacity\":{\"type\":\"range\",\"min\":10,\"max\":16000},\"iops\":{\"type\":\"dependent_range\",\"min\":100,\"max\":48000}}",
+			verify: func(t *testing.T, profile *models.VolumeProfile, err error) {
+				assert.Nil(t, err)
+				if assert.NotNil(t, profile) {
+					assert.Equal(t, "custom", profile.Name)
+					assert.Equal(t, int64(10), profile.Capacity.Min)
+					assert.Equal(t, models.ProfileRangeTypeDependentRange, profile.Iops.Type)
+					assert.Equal(t, int64(48000), profile.Iops.Max)
+				}
+			},
+		},
+	}
+
+	for _, testcase := range testCases {
+		t.Run(testcase.name, func(t *testing.T) {
+			mux, client, teardown := test.SetupServer(t)
+			requestCount := 0
+			test.SetupMuxResponse(t, mux, vpcvolume.Version+"/volume/profiles/custom", http.MethodGet, nil, testcase.status, testcase.content, func(t *testing.T, r *http.Request) {
+				requestCount++
+			})
+
+			defer teardown()
+
+			logger.Info("Test case being executed", zap.Reflect("testcase", testcase.name))
+
+			profileService := vpcvolume.NewProfileManager(client)
+			profile, err := profileService.GetVolumeProfile("custom", logger)
+			logger.Info("Profile details", zap.Reflect("profile", profile))
+
+			if testcase.expectErr != "" && assert.Error(t, err) {
+				assert.Equal(t, testcase.expectErr, err.Error())
+			}
+			if testcase.verify != nil {
+				testcase.verify(t, profile, err)
+			}
+
+			// Successful lookups are served from the cache of the service
+			_, _ = profileService.GetVolumeProfile("custom", logger)
+			if err == nil {
+				assert.Equal(t, 1, requestCount)
+			} else {
+				assert.Equal(t, 2, requestCount)
+			}
+		})
+	}
+}
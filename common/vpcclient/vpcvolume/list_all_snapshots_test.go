@@ -0,0 +1,131 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package vpcvolume_test ...
+package vpcvolume_test
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/riaas/test"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/vpcvolume"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestListAllSnapshots(t *testing.T) {
+	// Setup new style zap logger
+	logger, _ := GetTestContextLogger()
+	defer logger.Sync()
+
+	testCases := []struct {
+		name string
+
+		// Response
+		status  int
+		content string
+
+		limit   int
+		start   string
+		filters *models.ListSnapshotFilters
+
+		// Expected return
+		expectErr string
+		verify    func(*testing.T, *models.SnapshotList)
+		muxVerify func(*testing.T, *http.Request)
+	}{
+		{
+			name:   "Verify that the correct endpoint is invoked",
+			status: http.StatusNoContent,
+		}, {
+			name:      "Verify that a 404 is returned to the caller",
+			status:    http.StatusNotFound,
+			content:   "{\"errors\":[{\"message\":\"testerr\"}]}",
+			expectErr: "Trace Code:, testerr Please check ",
+		}, {
+			name:    "Verify that the snapshot list and next link are parsed",
+			status:  http.StatusOK,
+			content: "{\"snapshots\":[{\"id\":\"snapshot1\",\"size\":10,\"source_volume\":{\"id\":\"volume1\"}}],\"next\":{\"href\":\"https://region.iaas.cloud.ibm.com/v1/snapshots?start=snapshot2\\u0026limit=1\"},\"limit\":1}",
+			verify: func(t *testing.T, snapshots *models.SnapshotList) {
+				assert.Equal(t, 1, len(snapshots.Snapshots))
+				assert.Equal(t, "snapshot1", snapshots.Snapshots[0].ID)
+				assert.Equal(t, "volume1", snapshots.Snapshots[0].SourceVolume.ID)
+				assert.NotNil(t, snapshots.Next)
+			},
+		}, {
+			name:   "Verify that limit and start are added to the query",
+			limit:  12,
+			start:  "x-y-z",
+			status: http.StatusNoContent,
+			muxVerify: func(t *testing.T, r *http.Request) {
+				expectedValues := url.Values{"limit": []string{"12"}, "start": []string{"x-y-z"}, "version": []string{models.APIVersion}}
+				actualValues := r.URL.Query()
+				assert.Equal(t, expectedValues, actualValues)
+			},
+		}, {
+			name: "Verify that filters are added to the query",
+			filters: &models.ListSnapshotFilters{
+				ResourceGroupID: "rgid",
+				Tag:             "taggg",
+				SnapshotName:    "testname",
+				SourceVolumeID:  "volume1",
+			},
+			status: http.StatusNoContent,
+			muxVerify: func(t *testing.T, r *http.Request) {
+				expectedValues := url.Values{
+					"resource_group.id": []string{"rgid"},
+					"tag":               []string{"taggg"},
+					"name":              []string{"testname"},
+					"source_volume.id":  []string{"volume1"},
+					"version":           []string{models.APIVersion},
+				}
+				actualValues := r.URL.Query()
+				assert.Equal(t, expectedValues, actualValues)
+			},
+		},
+	}
+
+	for _, testcase := range testCases {
+		t.Run(testcase.name, func(t *testing.T) {
+			mux, client, teardown := test.SetupServer(t)
+			test.SetupMuxResponse(t, mux, vpcvolume.Version+"/snapshots", http.MethodGet, nil, testcase.status, testcase.content, testcase.muxVerify)
+
+			defer teardown()
+
+			logger.Info("Test case being executed", zap.Reflect("testcase", testcase.name))
+
+			snapshotService := vpcvolume.NewSnapshotManager(client)
+
+			snapshots, err := snapshotService.ListAllSnapshots(testcase.limit, testcase.start, testcase.filters, logger)
+			logger.Info("Snapshots", zap.Reflect("snapshots", snapshots))
+
+			if testcase.expectErr != "" && assert.Error(t, err) {
+				assert.Equal(t, testcase.expectErr, err.Error())
+				assert.Nil(t, snapshots)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, snapshots)
+			}
+
+			if testcase.verify != nil {
+				testcase.verify(t, snapshots)
+			}
+		})
+	}
+}
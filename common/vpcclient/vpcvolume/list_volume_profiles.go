@@ -0,0 +1,92 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package vpcvolume ...
+package vpcvolume
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+
+	util "github.com/IBM/ibmcloud-volume-interface/lib/utils"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/client"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
+	"go.uber.org/zap"
+)
+
+// maxProfilesLimit is the page size used to list the volume profiles
+const maxProfilesLimit = 100
+
+// ListVolumeProfiles GETs /volume/profiles, following all the pages. The result is cached by the service
+func (ps *ProfileService) ListVolumeProfiles(ctxLogger *zap.Logger) (*models.VolumeProfileList, error) {
+	ctxLogger.Debug("Entry Backend ListVolumeProfiles")
+	defer ctxLogger.Debug("Exit Backend ListVolumeProfiles")
+
+	defer util.TimeTracker("ListVolumeProfiles", time.Now())
+
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+
+	if ps.profileList != nil {
+		ctxLogger.Info("Returning cached volume profiles")
+		return ps.profileList, nil
+	}
+
+	operation := &client.Operation{
+		Name:        "ListVolumeProfiles",
+		Method:      "GET",
+		PathPattern: volumeProfilesPath,
+	}
+
+	profileList := &models.VolumeProfileList{}
+	start := ""
+	for {
+		var profiles models.VolumeProfileList
+		var apiErr models.Error
+
+		request := ps.client.NewRequest(operation)
+		ctxLogger.Info("Equivalent curl command", zap.Reflect("URL", request.URL()), zap.Reflect("Operation", operation))
+
+		req := request.JSONSuccess(&profiles).JSONError(&apiErr)
+		req.AddQueryValue("limit", strconv.Itoa(maxProfilesLimit))
+		if start != "" {
+			req.AddQueryValue("start", start)
+		}
+
+		_, err := req.Invoke()
+		if err != nil {
+			return nil, err
+		}
+
+		profileList.Profiles = append(profileList.Profiles, profiles.Profiles...)
+		if profiles.Next == nil {
+			break
+		}
+		nextURL, err := url.Parse(profiles.Next.Href)
+		if err != nil || nextURL.Query().Get("start") == "" {
+			ctxLogger.Warn("Next.Href is not in expected format", zap.Reflect("Next.Href", profiles.Next.Href))
+			break
+		}
+		start = nextURL.Query().Get("start")
+	}
+	profileList.TotalCount = len(profileList.Profiles)
+
+	for _, profile := range profileList.Profiles {
+		ps.profiles[profile.Name] = profile
+	}
+	ps.profileList = profileList
+	return profileList, nil
+}
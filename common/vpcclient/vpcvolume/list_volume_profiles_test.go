@@ -0,0 +1,143 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package vpcvolume_test ...
+package vpcvolume_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/riaas/test"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/vpcvolume"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestListVolumeProfiles(t *testing.T) {
+	// Setup new style zap logger
+	logger, _ := GetTestContextLogger()
+	defer logger.Sync()
+
+	testCases := []struct {
+		name string
+
+		// Response
+		status  int
+		content string
+
+		// Expected return
+		expectErr string
+		verify    func(*testing.T, *models.VolumeProfileList)
+		muxVerify func(*testing.T, *http.Request)
+	}{
+		{
+			name:   "Verify that the correct endpoint is invoked",
+			status: http.StatusNoContent,
+			muxVerify: func(t *testing.T, r *http.Request) {
+				assert.Equal(t, "100", r.URL.Query().Get("limit"))
+			},
+		}, {
+			name:      "Verify that a 404 is returned to the caller",
+			status:    http.StatusNotFound,
+			content:   "{\"errors\":[{\"message\":\"testerr\"}]}",
+			expectErr: "Trace Code:, testerr Please check ",
+		}, {
+			name:    "Verify that the profile list is parsed",
+			status:  http.StatusOK,
+			content: "{\"profiles\":[{\"name\":\"general-purpose\",\"family\":\"tiered\",\"capacity\":{\"type\":\"range\",\"min\":10,\"max\":16000,\"step\":1},\"iops\":{\"type\":\"dependent\"}},{\"name\":\"custom\",\"family\":\"custom\"}]}",
+			verify: func(t *testing.T, profiles *models.VolumeProfileList) {
+				assert.Equal(t, 2, len(profiles.Profiles))
+				assert.Equal(t, 2, profiles.TotalCount)
+				assert.Equal(t, "general-purpose", profiles.Profiles[0].Name)
+				assert.Equal(t, "tiered", profiles.Profiles[0].Family)
+				assert.Equal(t, int64(16000), profiles.Profiles[0].Capacity.Max)
+				assert.Equal(t, models.ProfileRangeTypeDependent, profiles.Profiles[0].Iops.Type)
+				assert.Nil(t, profiles.Profiles[1].Capacity)
+			},
+		},
+	}
+
+	for _, testcase := range testCases {
+		t.Run(testcase.name, func(t *testing.T) {
+			mux, client, teardown := test.SetupServer(t)
+			test.SetupMuxResponse(t, mux, vpcvolume.Version+"/volume/profiles", http.MethodGet, nil, testcase.status, testcase.content, testcase.muxVerify)
+
+			defer teardown()
+
+			logger.Info("Test case being executed", zap.Reflect("testcase", testcase.name))
+
+			profileService := vpcvolume.NewProfileManager(client)
+
+			profiles, err := profileService.ListVolumeProfiles(logger)
+			logger.Info("Profiles", zap.Reflect("profiles", profiles))
+
+			if testcase.expectErr != "" && assert.Error(t, err) {
+				assert.Equal(t, testcase.expectErr, err.Error())
+				assert.Nil(t, profiles)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, profiles)
+			}
+
+			if testcase.verify != nil {
+				testcase.verify(t, profiles)
+			}
+		})
+	}
+}
+
+func TestListVolumeProfilesPagesAndCache(t *testing.T) {
+	// Setup new style zap logger
+	logger, _ := GetTestContextLogger()
+	defer logger.Sync()
+
+	mux, client, teardown := test.SetupServer(t)
+	defer teardown()
+
+	requestedStarts := []string{}
+	mux.HandleFunc(vpcvolume.Version+"/volume/profiles", func(w http.ResponseWriter, r *http.Request) {
+		start := r.URL.Query().Get("start")
+		requestedStarts = append(requestedStarts, start)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if start == "" {
+			fmt.Fprint(w, "{\"profiles\":[{\"name\":\"general-purpose\"}],\"next\":{\"href\":\"https://region.iaas.cloud.ibm.com/v1/volume/profiles?start=custom\\u0026limit=1\"}}")
+			return
+		}
+		fmt.Fprint(w, "{\"profiles\":[{\"name\":\"custom\"}]}")
+	})
+
+	profileService := vpcvolume.NewProfileManager(client)
+
+	profiles, err := profileService.ListVolumeProfiles(logger)
+	assert.NoError(t, err)
+	if assert.NotNil(t, profiles) {
+		assert.Equal(t, 2, len(profiles.Profiles))
+		assert.Equal(t, "custom", profiles.Profiles[1].Name)
+	}
+	assert.Equal(t, []string{"", "custom"}, requestedStarts)
+
+	// Served from the cache of the service
+	profiles, err = profileService.ListVolumeProfiles(logger)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(profiles.Profiles))
+	profile, err := profileService.GetVolumeProfile("custom", logger)
+	assert.NoError(t, err)
+	assert.Equal(t, "custom", profile.Name)
+	assert.Equal(t, 2, len(requestedStarts))
+}
@@ -0,0 +1,99 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcvolume_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/riaas/test"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/vpcvolume"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestPatchVolume(t *testing.T) {
+	// Setup new style zap logger
+	logger, _ := GetTestContextLogger()
+	defer logger.Sync()
+
+	testCases := []struct {
+		name string
+
+		// Response
+		status  int
+		content string
+
+		// Expected return
+		expectErr string
+		verify    func(*testing.T, *models.Volume, error)
+	}{
+		{
+			name:   "Verify that the correct endpoint is invoked",
+			status: http.StatusNoContent,
+		}, {
+			name:      "Verify that a 400 is returned to the caller",
+			status:    http.StatusBadRequest,
+			content:   "{\"errors\":[{\"message\":\"testerr\"}]}",
+			expectErr: "Trace Code:, testerr Please check ",
+		}, {
+			name:    "Verify that the volume is parsed correctly",
+			status:  http.StatusOK,
+			content: "{\"id\":\"volume-id\",\"name\":\"volume-name\",\"capacity\":20,\"iops\":3000,\"status\":\"updating\",\"zone\":{\"name\":\"test-1\"}}",
+			verify: func(t *testing.T, volume *models.Volume, err error) {
+				if assert.NotNil(t, volume) {
+					assert.Equal(t, "volume-id", volume.ID)
+					assert.Equal(t, int64(20), volume.Capacity)
+				}
+			},
+		},
+	}
+
+	for _, testcase := range testCases {
+		t.Run(testcase.name, func(t *testing.T) {
+			volumePatch := &models.VolumePatch{
+				Capacity: 20,
+			}
+			expectedContent := "{\"capacity\":20}\n"
+
+			mux, client, teardown := test.SetupServer(t)
+			test.SetupMuxResponse(t, mux, vpcvolume.Version+"/volumes/volume-id", http.MethodPatch, &expectedContent, testcase.status, testcase.content, nil)
+
+			defer teardown()
+
+			logger.Info("Test case being executed", zap.Reflect("testcase", testcase.name))
+
+			volumeService := vpcvolume.New(client)
+
+			volume, err := volumeService.PatchVolume("volume-id", volumePatch, logger)
+			logger.Info("Volume details", zap.Reflect("volume", volume))
+
+			if testcase.expectErr != "" && assert.Error(t, err) {
+				assert.Equal(t, testcase.expectErr, err.Error())
+				assert.Nil(t, volume)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, volume)
+			}
+
+			if testcase.verify != nil {
+				testcase.verify(t, volume, err)
+			}
+		})
+	}
+}
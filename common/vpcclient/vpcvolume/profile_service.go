@@ -0,0 +1,54 @@
+/**
+ * Copyright 2020 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package vpcvolume ...
+package vpcvolume
+
+import (
+	"sync"
+
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/client"
+	"github.com/IBM/ibmcloud-volume-vpc/common/vpcclient/models"
+	"go.uber.org/zap"
+)
+
+// ProfileManager operations
+type ProfileManager interface {
+	// List all the volume profiles
+	ListVolumeProfiles(ctxLogger *zap.Logger) (*models.VolumeProfileList, error)
+
+	// Get the volume profile by using profile name
+	GetVolumeProfile(profileName string, ctxLogger *zap.Logger) (*models.VolumeProfile, error)
+}
+
+// ProfileService caches the volume profiles it fetched, as they hardly ever change
+// the cache is kept for the lifetime of the service
+type ProfileService struct {
+	client client.SessionClient
+
+	mutex       sync.Mutex
+	profiles    map[string]*models.VolumeProfile
+	profileList *models.VolumeProfileList
+}
+
+var _ ProfileManager = &ProfileService{}
+
+// NewProfileManager ...
+func NewProfileManager(client client.SessionClient) ProfileManager {
+	return &ProfileService{
+		client:   client,
+		profiles: map[string]*models.VolumeProfile{},
+	}
+}
@@ -31,12 +31,21 @@ type SnapshotManager interface {
 	// Delete the snapshot
 	DeleteSnapshot(volumeID string, snapshotID string, ctxLogger *zap.Logger) error
 
+	// Delete the snapshot by using snapshot ID only
+	DeleteSnapshotByID(snapshotID string, ctxLogger *zap.Logger) error
+
 	// Get the snapshot
 	GetSnapshot(volumeID string, snapshotID string, ctxLogger *zap.Logger) (*models.Snapshot, error)
 
+	// Get the snapshot by using snapshot ID only
+	GetSnapshotByID(snapshotID string, ctxLogger *zap.Logger) (*models.Snapshot, error)
+
 	// List all the  snapshots for a given volume
 	ListSnapshots(volumeID string, ctxLogger *zap.Logger) (*models.SnapshotList, error)
 
+	// List all the snapshots in the account, optionally filtered
+	ListAllSnapshots(limit int, start string, filters *models.ListSnapshotFilters, ctxLogger *zap.Logger) (*models.SnapshotList, error)
+
 	// Set tag for a snapshot
 	SetSnapshotTag(volumeID string, snapshotID string, tagName string, ctxLogger *zap.Logger) error
 
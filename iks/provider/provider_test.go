@@ -182,6 +182,7 @@ func GetTestProvider(t *testing.T, logger *zap.Logger) (local.Provider, error) {
 	// Inject a fake RIAAS API client
 	cp = &fakes.RegionalAPIClientProvider{}
 	uc = &fakes.RegionalAPI{}
+	uc.WithContextReturns(uc)
 	cp.NewReturnsOnCall(0, uc, nil)
 	sc = &fakes.RegionalAPI{}
 	cp.NewReturnsOnCall(1, sc, nil)
@@ -261,6 +262,7 @@ func GetTestOpenSession(t *testing.T, logger *zap.Logger) (sessn *IksVpcSession,
 	// Inject a fake RIAAS API client
 	cp := &fakes.RegionalAPIClientProvider{}
 	uc = &fakes.RegionalAPI{}
+	uc.WithContextReturns(uc)
 	cp.NewReturnsOnCall(0, uc, nil)
 	sc = &fakes.RegionalAPI{}
 	cp.NewReturnsOnCall(1, sc, nil)
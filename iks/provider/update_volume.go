@@ -45,8 +45,8 @@ func (vpcIks *IksVpcSession) UpdateVolume(volumeRequest provider.Volume) (err er
 	vpcIks.Logger.Info("Successfully validated inputs for UpdateVolume request... ")
 
 	vpcIks.Logger.Info("Calling  provider for volume update...")
-	err = vpcIks.APIRetry.FlexyRetry(vpcIks.Logger, func() (error, bool) {
-		err = vpcIks.IksSession.Apiclient.VolumeService().UpdateVolume(&volumeTemplate, vpcIks.Logger)
+	err = vpcIks.APIRetry.FlexyRetry(vpcIks.GetContext(), vpcIks.Logger, func() (error, bool) {
+		err = vpcIks.IksSession.Apiclient.WithContext(vpcIks.GetContext()).VolumeService().UpdateVolume(&volumeTemplate, vpcIks.Logger)
 		return err, err == nil || vpc_provider.SkipRetryForIKS(err)
 	})
 